@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+// The administration APIs of vRA create, read, update and delete objects like the entitlements and
+// the reservations at a path. These functions share the calls of the clients of those objects.
+
+// IsNotFoundError returns true if the error is the response of vRA to an object that does not exist
+func IsNotFoundError(err error) bool {
+	apiError, ok := err.(APIError)
+	if !ok {
+		return false
+	}
+	for _, e := range apiError.Errors {
+		if e.Code == http.StatusNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// getObject reads the object at the path
+func (c *APIClient) getObject(path string, queryParameters map[string]string, object interface{}) error {
+	url := c.BuildEncodedURL(path, queryParameters)
+	resp, respErr := c.Get(url, nil)
+	if respErr != nil {
+		return respErr
+	}
+	return utils.UnmarshalJSON(resp.Body, object)
+}
+
+// listObjects reads all the pages of the objects at the path and appends their content to the objects,
+// which is a pointer to a slice
+func (c *APIClient) listObjects(path string, queryParameters map[string]string, objects interface{}) error {
+	list := reflect.ValueOf(objects).Elem()
+	parameters := make(map[string]string, len(queryParameters)+1)
+	for key, value := range queryParameters {
+		parameters[key] = value
+	}
+	for page, totalPages := 1, 1; page <= totalPages; page++ {
+		parameters["page"] = strconv.Itoa(page)
+		var response struct {
+			Content  json.RawMessage `json:"content,omitempty"`
+			MetaData Metadata        `json:"metadata,omitempty"`
+		}
+		if err := c.getObject(path, parameters, &response); err != nil {
+			return err
+		}
+		if len(response.Content) > 0 {
+			content := reflect.New(list.Type())
+			if err := utils.UnmarshalJSON(response.Content, content.Interface()); err != nil {
+				return err
+			}
+			list.Set(reflect.AppendSlice(list, content.Elem()))
+		}
+		totalPages = response.MetaData.TotalPages
+	}
+	return nil
+}
+
+// createObject posts the object to the path and reads the created object, from the response or
+// else from the location of the created object
+func (c *APIClient) createObject(path string, object interface{}, created interface{}) error {
+	buffer, err := utils.MarshalToJSON(object)
+	if err != nil {
+		return err
+	}
+	url := c.BuildEncodedURL(path, nil)
+	resp, respErr := c.Post(url, buffer, nil)
+	if respErr != nil {
+		return respErr
+	}
+	if len(resp.Body) == 0 && resp.Location != "" {
+		resp, respErr = c.Get(resp.Location, nil)
+		if respErr != nil {
+			return respErr
+		}
+	}
+	return utils.UnmarshalJSON(resp.Body, created)
+}
+
+// updateObject puts the object at the path and reads the updated object
+func (c *APIClient) updateObject(path string, object interface{}, updated interface{}) error {
+	buffer, err := utils.MarshalToJSON(object)
+	if err != nil {
+		return err
+	}
+	url := c.BuildEncodedURL(path, nil)
+	resp, respErr := c.Put(url, buffer, nil)
+	if respErr != nil {
+		return respErr
+	}
+	if len(resp.Body) == 0 {
+		return c.getObject(path, nil, updated)
+	}
+	return utils.UnmarshalJSON(resp.Body, updated)
+}
+
+// deleteObject deletes the object at the path
+func (c *APIClient) deleteObject(path string) error {
+	url := c.BuildEncodedURL(path, nil)
+	_, respErr := c.Delete(url, nil, nil)
+	return respErr
+}
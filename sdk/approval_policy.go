@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// approval policy API constants
+const (
+	ApprovalServiceAPI  = "/approval-service/api"
+	ApprovalPoliciesAPI = ApprovalServiceAPI + "/policies"
+	ApprovalPolicyAPI   = ApprovalPoliciesAPI + "/%s"
+
+	// types of the approval policies, which are the types of their pre-approval phase
+	ApprovalPolicyTypeCatalogRequest = "com.vmware.cafe.catalog.request.pre"
+	ApprovalPolicyTypeResourceAction = "com.vmware.cafe.catalog.resourceAction.request.pre"
+	ApprovalPolicyTypeReconfigure    = ApprovalPolicyTypeResourceAction + ".Infrastructure.Virtual.Action.Reconfigure"
+
+	// status of an approval policy
+	ApprovalPolicyStatusDraft    = "DRAFT"
+	ApprovalPolicyStatusActive   = "ACTIVE"
+	ApprovalPolicyStatusInactive = "INACTIVE"
+
+	// whether any or all the approvers of a level have to approve
+	ApprovalModeAny = "ANY"
+	ApprovalModeAll = "ALL"
+
+	// who approves at a level
+	ApproverTypeSpecificUsersGroups = "SPECIFIC_USERS_GROUPS"
+
+	// types of the clauses of the condition of a level
+	ConditionClauseAnd        = "and"
+	ConditionClauseOr         = "or"
+	ConditionClauseExpression = "expression"
+
+	// operators of the expressions of the condition of a level
+	ConditionOperatorEquals      = "equals"
+	ConditionOperatorNotEquals   = "notEquals"
+	ConditionOperatorLessThan    = "lessThan"
+	ConditionOperatorGreaterThan = "greaterThan"
+	ConditionOperatorContains    = "contains"
+	ConditionOperatorStartsWith  = "startsWith"
+	ConditionOperatorEndsWith    = "endsWith"
+
+	// types of the operands of the expressions
+	OperandTypePath     = "path"
+	OperandTypeConstant = "constant"
+)
+
+// ApprovalPolicy - approval levels that the requests of a type go through before they are processed
+type ApprovalPolicy struct {
+	ID           string          `json:"id,omitempty"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description,omitempty"`
+	TenantID     string          `json:"tenantId,omitempty"`
+	PolicyTypeID string          `json:"policyTypeId"`
+	State        string          `json:"state"`
+	Phases       []ApprovalPhase `json:"phases"`
+	Version      int             `json:"version,omitempty"`
+}
+
+// ApprovalPhase - phase of an approval policy, before or after the request is processed
+type ApprovalPhase struct {
+	ID          string          `json:"id,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	PhaseTypeID string          `json:"phaseTypeId"`
+	Levels      []ApprovalLevel `json:"levels"`
+}
+
+// ApprovalLevel - level of approval of a phase, required always or when its condition matches the request
+type ApprovalLevel struct {
+	ID             string             `json:"id,omitempty"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description,omitempty"`
+	LevelNumber    int                `json:"levelNumber"`
+	ApprovalMode   string             `json:"approvalMode"`
+	ApproverType   string             `json:"approverType"`
+	Approvers      []Principal        `json:"approvers"`
+	AlwaysRequired bool               `json:"alwaysRequired"`
+	Condition      *ApprovalCondition `json:"condition,omitempty"`
+}
+
+// ApprovalCondition - clause of the condition of a level: an expression, or the and or the or of sub clauses
+type ApprovalCondition struct {
+	Type         string              `json:"type"`
+	Operator     string              `json:"operator,omitempty"`
+	LeftOperand  *ConditionOperand   `json:"leftOperand,omitempty"`
+	RightOperand *ConditionOperand   `json:"rightOperand,omitempty"`
+	SubClauses   []ApprovalCondition `json:"subClauses,omitempty"`
+}
+
+// ConditionOperand - operand of an expression: the path of a field of the request, or a constant value
+type ConditionOperand struct {
+	Type  string        `json:"type"`
+	Path  string        `json:"path,omitempty"`
+	Value *LiteralValue `json:"value,omitempty"`
+}
+
+// PrePhase returns the pre-approval phase of the approval policy, or nil if it has none
+func (p *ApprovalPolicy) PrePhase() *ApprovalPhase {
+	for i := range p.Phases {
+		if p.Phases[i].PhaseTypeID == p.PolicyTypeID {
+			return &p.Phases[i]
+		}
+	}
+	return nil
+}
+
+// CreateApprovalPolicy creates the approval policy and returns it with its id
+func (c *APIClient) CreateApprovalPolicy(policy *ApprovalPolicy) (*ApprovalPolicy, error) {
+	var created ApprovalPolicy
+	if err := c.createObject(ApprovalPoliciesAPI, policy, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetApprovalPolicy returns the approval policy with the id
+func (c *APIClient) GetApprovalPolicy(id string) (*ApprovalPolicy, error) {
+	var policy ApprovalPolicy
+	if err := c.getObject(fmt.Sprintf(ApprovalPolicyAPI, id), nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// UpdateApprovalPolicy replaces the approval policy with the same id
+func (c *APIClient) UpdateApprovalPolicy(policy *ApprovalPolicy) (*ApprovalPolicy, error) {
+	var updated ApprovalPolicy
+	if err := c.updateObject(fmt.Sprintf(ApprovalPolicyAPI, policy.ID), policy, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteApprovalPolicy deletes the approval policy with the id
+func (c *APIClient) DeleteApprovalPolicy(id string) error {
+	return c.deleteObject(fmt.Sprintf(ApprovalPolicyAPI, id))
+}
+
+// FindApprovalPolicies returns the approval policies with the name
+func (c *APIClient) FindApprovalPolicies(name string) ([]ApprovalPolicy, error) {
+	var policies []ApprovalPolicy
+	err := c.listObjects(ApprovalPoliciesAPI, map[string]string{
+		"$filter": fmt.Sprintf("name eq '%s'", strings.Replace(name, "'", "''", -1))}, &policies)
+	if err != nil {
+		return nil, err
+	}
+	matching := policies[:0]
+	for _, policy := range policies {
+		if policy.Name == name {
+			matching = append(matching, policy)
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestApprovalPolicy(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "7a8b9c0d-0000-4000-8000-000000000050"
+	url := client.BuildEncodedURL(fmt.Sprintf(ApprovalPolicyAPI, id), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, approvalPolicyResponse))
+
+	policy, err := client.GetApprovalPolicy(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, ApprovalPolicyTypeCatalogRequest, policy.PolicyTypeID)
+	utils.AssertEqualsString(t, ApprovalPolicyStatusActive, policy.State)
+	phase := policy.PrePhase()
+	utils.AssertTrue(t, "pre-approval phase", phase != nil)
+	utils.AssertEqualsString(t, "p-1", phase.ID)
+	utils.AssertEqualsInt(t, 2, len(phase.Levels))
+	condition := phase.Levels[0].Condition
+	utils.AssertEqualsString(t, ConditionClauseOr, condition.Type)
+	utils.AssertEqualsString(t, "~cpu", condition.SubClauses[0].LeftOperand.Path)
+	utils.AssertEqualsString(t, "4", condition.SubClauses[0].RightOperand.Value.String())
+	utils.AssertTrue(t, "always required", phase.Levels[1].AlwaysRequired)
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(ApprovalPoliciesAPI, nil),
+		httpmock.NewStringResponder(201, approvalPolicyResponse))
+	created, err := client.CreateApprovalPolicy(&ApprovalPolicy{Name: "Production approval", PolicyTypeID: ApprovalPolicyTypeCatalogRequest})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, created.ID)
+
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(204, ""))
+	updated, err := client.UpdateApprovalPolicy(policy)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 3, updated.Version)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeleteApprovalPolicy(id))
+}
+
+func TestFindApprovalPolicies(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	url := client.BuildEncodedURL(ApprovalPoliciesAPI, map[string]string{
+		"$filter": "name eq 'Production approval'",
+		"page":    "1"})
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, approvalPoliciesResponse))
+
+	// only the policies with the exact name are returned
+	policies, err := client.FindApprovalPolicies("Production approval")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(policies))
+	utils.AssertEqualsString(t, "7a8b9c0d-0000-4000-8000-000000000050", policies[0].ID)
+}
@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// catalog service API constants
+const (
+	ServicesAPI     = CatalogServiceAPI + "/services"
+	ServiceAPI      = ServicesAPI + "/%s"
+	CatalogItemsAPI = CatalogServiceAPI + "/catalogItems"
+	CatalogItemAPI  = CatalogItemsAPI + "/%s"
+
+	// status of a catalog service
+	ServiceStatusActive   = "ACTIVE"
+	ServiceStatusInactive = "INACTIVE"
+)
+
+// Service - service of the catalog that groups catalog items
+type Service struct {
+	ID           string                  `json:"id,omitempty"`
+	Name         string                  `json:"name"`
+	Description  string                  `json:"description,omitempty"`
+	Status       string                  `json:"status,omitempty"`
+	Organization EntitlementOrganization `json:"organization"`
+	Owner        *Principal              `json:"owner,omitempty"`
+	SupportTeam  *Principal              `json:"supportTeam,omitempty"`
+	Hours        *ServiceHours           `json:"hours,omitempty"`
+	Version      int                     `json:"version,omitempty"`
+}
+
+// ServiceHours - hours during which the service is supported
+type ServiceHours struct {
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+}
+
+// ServiceCatalogItem - catalog item as it is managed by a catalog administrator
+type ServiceCatalogItem struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name,omitempty"`
+	ServiceRef *Reference `json:"serviceRef,omitempty"`
+}
+
+// CreateCatalogService creates the catalog service and returns it with its id
+func (c *APIClient) CreateCatalogService(service *Service) (*Service, error) {
+	var created Service
+	if err := c.createObject(ServicesAPI, service, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetCatalogService returns the catalog service with the id
+func (c *APIClient) GetCatalogService(id string) (*Service, error) {
+	var service Service
+	if err := c.getObject(fmt.Sprintf(ServiceAPI, id), nil, &service); err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
+
+// UpdateCatalogService replaces the catalog service with the same id
+func (c *APIClient) UpdateCatalogService(service *Service) (*Service, error) {
+	var updated Service
+	if err := c.updateObject(fmt.Sprintf(ServiceAPI, service.ID), service, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteCatalogService deletes the catalog service with the id
+func (c *APIClient) DeleteCatalogService(id string) error {
+	return c.deleteObject(fmt.Sprintf(ServiceAPI, id))
+}
+
+// GetServiceCatalogItems returns the catalog items that belong to the catalog service
+func (c *APIClient) GetServiceCatalogItems(serviceID string) ([]ServiceCatalogItem, error) {
+	var catalogItems []ServiceCatalogItem
+	err := c.listObjects(CatalogItemsAPI, map[string]string{
+		"$filter": fmt.Sprintf("service/id eq '%s'", strings.Replace(serviceID, "'", "''", -1))}, &catalogItems)
+	if err != nil {
+		return nil, err
+	}
+	return catalogItems, nil
+}
+
+// SetCatalogItemService moves the catalog item to the catalog service, or out of any service if the
+// service id is empty. The other properties of the catalog item are kept as they are.
+func (c *APIClient) SetCatalogItemService(catalogItemID, serviceID string) error {
+	path := fmt.Sprintf(CatalogItemAPI, catalogItemID)
+	var catalogItem map[string]interface{}
+	if err := c.getObject(path, nil, &catalogItem); err != nil {
+		return err
+	}
+	if serviceID == "" {
+		catalogItem["serviceRef"] = nil
+	} else {
+		catalogItem["serviceRef"] = Reference{ID: serviceID}
+	}
+	var updated map[string]interface{}
+	return c.updateObject(path, catalogItem, &updated)
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestCatalogService(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "a1b2c3d4-0000-4000-8000-000000000001"
+	url := client.BuildEncodedURL(fmt.Sprintf(ServiceAPI, id), nil)
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, catalogServiceResponse))
+
+	service, err := client.GetCatalogService(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "Linux", service.Name)
+	utils.AssertEqualsString(t, ServiceStatusActive, service.Status)
+	utils.AssertEqualsString(t, "jdoe@example.com", service.Owner.Ref)
+	utils.AssertEqualsString(t, PrincipalTypeGroup, service.SupportTeam.Type)
+	utils.AssertEqualsString(t, "18:00", service.Hours.EndTime)
+	utils.AssertEqualsInt(t, 2, service.Version)
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(ServicesAPI, nil),
+		httpmock.NewStringResponder(201, catalogServiceResponse))
+	created, err := client.CreateCatalogService(&Service{Name: "Linux"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, created.ID)
+
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(200, ""))
+	updated, err := client.UpdateCatalogService(service)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, updated.ID)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeleteCatalogService(id))
+}
+
+func TestGetServiceCatalogItems(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "a1b2c3d4-0000-4000-8000-000000000001"
+	pageURL := func(page string) string {
+		return client.BuildEncodedURL(CatalogItemsAPI, map[string]string{
+			"$filter": "service/id eq '" + id + "'",
+			"page":    page})
+	}
+	httpmock.RegisterResponder("GET", pageURL("1"),
+		httpmock.NewStringResponder(200, serviceCatalogItemsPage1Response))
+	httpmock.RegisterResponder("GET", pageURL("2"),
+		httpmock.NewStringResponder(200, serviceCatalogItemsPage2Response))
+
+	catalogItems, err := client.GetServiceCatalogItems(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 2, len(catalogItems))
+	utils.AssertEqualsString(t, "feaedf73-560c-4612-a573-41667e017691", catalogItems[0].ID)
+	utils.AssertEqualsString(t, "Ubuntu 18.04", catalogItems[1].Name)
+	utils.AssertEqualsString(t, id, catalogItems[1].ServiceRef.ID)
+}
+
+func TestSetCatalogItemService(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	catalogItemID := "feaedf73-560c-4612-a573-41667e017691"
+	url := client.BuildEncodedURL(fmt.Sprintf(CatalogItemAPI, catalogItemID), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, `{"id":"`+catalogItemID+`","name":"CentOS 7.0 x64","status":"PUBLISHED","serviceRef":null}`))
+
+	var body map[string]interface{}
+	httpmock.RegisterResponder("PUT", url,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			body = nil
+			if err := utils.UnmarshalJSON(data, &body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, string(data)), nil
+		})
+
+	// the other properties of the catalog item are kept
+	utils.AssertNilError(t, client.SetCatalogItemService(catalogItemID, "a1b2c3d4-0000-4000-8000-000000000001"))
+	utils.AssertEqualsString(t, "PUBLISHED", body["status"].(string))
+	utils.AssertEqualsString(t, "a1b2c3d4-0000-4000-8000-000000000001", body["serviceRef"].(map[string]interface{})["id"].(string))
+
+	utils.AssertNilError(t, client.SetCatalogItemService(catalogItemID, ""))
+	utils.AssertTrue(t, "service removed", body["serviceRef"] == nil)
+}
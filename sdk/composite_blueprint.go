@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+// composition service API constants
+const (
+	CompositionServiceAPI = "/composition-service/api"
+	BlueprintDocumentsAPI = CompositionServiceAPI + "/blueprintdocuments"
+	BlueprintDocumentAPI  = BlueprintDocumentsAPI + "/%s"
+	BlueprintAPI          = CompositionServiceAPI + "/blueprints/%s"
+	BlueprintStatusAPI    = BlueprintAPI + "/status"
+
+	// type of the document of a composite blueprint
+	CompositeBlueprintType = "CompositeBlueprint"
+
+	// status of a composite blueprint
+	BlueprintStatusDraft     = "DRAFT"
+	BlueprintStatusPublished = "PUBLISHED"
+	BlueprintStatusRetired   = "RETIRED"
+
+	// separator of the tenant and the blueprint id in the provider binding of a catalog item
+	providerBindingSeparator = "!::!"
+)
+
+// BlueprintDocument - document of a composite blueprint, with its properties, components and layout
+type BlueprintDocument map[string]interface{}
+
+// ID returns the id of the blueprint
+func (d BlueprintDocument) ID() string {
+	id, _ := d["id"].(string)
+	return id
+}
+
+// Status returns the publish status of the blueprint
+func (d BlueprintDocument) Status() string {
+	status, _ := d["status"].(string)
+	return status
+}
+
+// GetBlueprintDocument returns the document of the composite blueprint with the id
+func (c *APIClient) GetBlueprintDocument(id string) (BlueprintDocument, error) {
+	var document BlueprintDocument
+	if err := c.getObject(fmt.Sprintf(BlueprintDocumentAPI, id), nil, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// CreateBlueprintDocument creates the composite blueprint of the document
+func (c *APIClient) CreateBlueprintDocument(document BlueprintDocument) (BlueprintDocument, error) {
+	var created BlueprintDocument
+	if err := c.createObject(BlueprintDocumentsAPI, document, &created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateBlueprintDocument replaces the document of the composite blueprint with the same id
+func (c *APIClient) UpdateBlueprintDocument(document BlueprintDocument) (BlueprintDocument, error) {
+	var updated BlueprintDocument
+	if err := c.updateObject(fmt.Sprintf(BlueprintDocumentAPI, document.ID()), document, &updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// SetBlueprintStatus publishes the composite blueprint, or takes it back to draft
+func (c *APIClient) SetBlueprintStatus(id, status string) error {
+	buffer, err := utils.MarshalToJSON(map[string]string{"status": status})
+	if err != nil {
+		return err
+	}
+	url := c.BuildEncodedURL(fmt.Sprintf(BlueprintStatusAPI, id), nil)
+	_, respErr := c.Put(url, buffer, nil)
+	return respErr
+}
+
+// DeleteBlueprint deletes the composite blueprint with the id
+func (c *APIClient) DeleteBlueprint(id string) error {
+	return c.deleteObject(fmt.Sprintf(BlueprintAPI, id))
+}
+
+// GetBlueprintCatalogItem returns the catalog item of the published composite blueprint, or nil if the
+// blueprint has no catalog item
+func (c *APIClient) GetBlueprintCatalogItem(blueprintID string) (*ServiceCatalogItem, error) {
+	bindingID := c.Tenant + providerBindingSeparator + blueprintID
+	var catalogItems []ServiceCatalogItem
+	err := c.listObjects(CatalogItemsAPI, map[string]string{
+		"$filter": fmt.Sprintf("providerBinding/bindingId eq '%s'", strings.Replace(bindingID, "'", "''", -1))}, &catalogItems)
+	if err != nil {
+		return nil, err
+	}
+	if len(catalogItems) == 0 {
+		return nil, nil
+	}
+	return &catalogItems[0], nil
+}
@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestCompositeBlueprint(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "BasicSingleMachine"
+	url := client.BuildEncodedURL(fmt.Sprintf(BlueprintDocumentAPI, id), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, blueprintDocumentResponse))
+
+	document, err := client.GetBlueprintDocument(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, document.ID())
+	utils.AssertEqualsString(t, BlueprintStatusPublished, document.Status())
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(BlueprintDocumentsAPI, nil),
+		httpmock.NewStringResponder(201, blueprintDocumentResponse))
+	created, err := client.CreateBlueprintDocument(document)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, created.ID())
+
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(200, ""))
+	updated, err := client.UpdateBlueprintDocument(document)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "Basic Single Machine", updated["name"].(string))
+
+	var status string
+	httpmock.RegisterResponder("PUT", client.BuildEncodedURL(fmt.Sprintf(BlueprintStatusAPI, id), nil),
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			var body map[string]string
+			if err := utils.UnmarshalJSON(data, &body); err != nil {
+				return nil, err
+			}
+			status = body["status"]
+			return httpmock.NewStringResponse(200, ""), nil
+		})
+	utils.AssertNilError(t, client.SetBlueprintStatus(id, BlueprintStatusDraft))
+	utils.AssertEqualsString(t, BlueprintStatusDraft, status)
+
+	httpmock.RegisterResponder("DELETE", client.BuildEncodedURL(fmt.Sprintf(BlueprintAPI, id), nil),
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeleteBlueprint(id))
+}
+
+func TestGetBlueprintCatalogItem(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	url := func(blueprintID string) string {
+		return client.BuildEncodedURL(CatalogItemsAPI, map[string]string{
+			"$filter": "providerBinding/bindingId eq '" + client.Tenant + "!::!" + blueprintID + "'",
+			"page":    "1"})
+	}
+	httpmock.RegisterResponder("GET", url("BasicSingleMachine"),
+		httpmock.NewStringResponder(200, `{"content":[{"id":"feaedf73-560c-4612-a573-41667e017691","name":"Basic Single Machine"}],
+			"metadata":{"totalPages":1}}`))
+	httpmock.RegisterResponder("GET", url("Draft"),
+		httpmock.NewStringResponder(200, `{"content":[],"metadata":{"totalPages":0}}`))
+
+	catalogItem, err := client.GetBlueprintCatalogItem("BasicSingleMachine")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "feaedf73-560c-4612-a573-41667e017691", catalogItem.ID)
+
+	catalogItem, err = client.GetBlueprintCatalogItem("Draft")
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "no catalog item", catalogItem == nil)
+}
@@ -0,0 +1,177 @@
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"strings"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+// content management service API constants
+const (
+	ContentManagementServiceAPI = "/content-management-service/api"
+	ContentsAPI                 = ContentManagementServiceAPI + "/contents"
+	PackagesAPI                 = ContentManagementServiceAPI + "/packages"
+	PackageAPI                  = PackagesAPI + "/%s"
+	ValidatePackageAPI          = PackagesAPI + "/validate"
+	AppZip                      = "application/zip"
+
+	// status of the validation or the import of a content package
+	OperationStatusSuccess = "SUCCESS"
+	OperationStatusWarning = "WARNING"
+	OperationStatusFailed  = "FAILED"
+
+	// how the import of a content package resolves the contents that already exist
+	ResolutionModeSkip      = "SKIP"
+	ResolutionModeOverwrite = "OVERWRITE"
+)
+
+// Content - content of the tenant that can be exported in a package, like a blueprint or a property definition
+type Content struct {
+	ID            string `json:"id,omitempty"`
+	ContentID     string `json:"contentId,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	ContentTypeID string `json:"contentTypeId,omitempty"`
+}
+
+// ContentPackage - package of the contents to export
+type ContentPackage struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Contents    []string `json:"contents"`
+}
+
+// PackageOperationResult - result of the validation or the import of a content package
+type PackageOperationResult struct {
+	// the id of the imported package, in the location of the response to the import
+	PackageID        string                   `json:"-"`
+	OperationType    string                   `json:"operationType,omitempty"`
+	OperationStatus  string                   `json:"operationStatus,omitempty"`
+	OperationResults []ContentOperationResult `json:"operationResults,omitempty"`
+}
+
+// ContentOperationResult - result of the validation or the import of a content of a package
+type ContentOperationResult struct {
+	ContentID           string   `json:"contentId,omitempty"`
+	ContentName         string   `json:"contentName,omitempty"`
+	ContentTypeID       string   `json:"contentTypeId,omitempty"`
+	OperationStatusType string   `json:"operationStatusType,omitempty"`
+	Messages            []string `json:"messages,omitempty"`
+	OperationErrors     []string `json:"operationErrors,omitempty"`
+}
+
+// Failed returns true if the package, or one of its contents, failed the operation
+func (r *PackageOperationResult) Failed() bool {
+	if r.OperationStatus == OperationStatusFailed {
+		return true
+	}
+	for _, result := range r.OperationResults {
+		if result.OperationStatusType == OperationStatusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors returns the errors and the messages of the contents that failed the operation
+func (r *PackageOperationResult) Errors() []string {
+	var errors []string
+	for _, result := range r.OperationResults {
+		if result.OperationStatusType != OperationStatusFailed {
+			continue
+		}
+		messages := append(append([]string{}, result.OperationErrors...), result.Messages...)
+		errors = append(errors, fmt.Sprintf("%s %s: %s", result.ContentTypeID, result.ContentName, strings.Join(messages, "; ")))
+	}
+	return errors
+}
+
+// FindContents returns the contents of the content type with the name
+func (c *APIClient) FindContents(contentTypeID, name string) ([]Content, error) {
+	var contents []Content
+	err := c.listObjects(ContentsAPI, map[string]string{
+		"$filter": fmt.Sprintf("name eq '%s' and contentTypeId eq '%s'",
+			strings.Replace(name, "'", "''", -1), strings.Replace(contentTypeID, "'", "''", -1))}, &contents)
+	if err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+// ValidateContentPackage checks that the package zip can be imported in the tenant, without importing it
+func (c *APIClient) ValidateContentPackage(fileName string, zip []byte) (*PackageOperationResult, error) {
+	return c.uploadContentPackage(ValidatePackageAPI, nil, fileName, zip)
+}
+
+// ImportContentPackage imports the contents of the package zip in the tenant
+func (c *APIClient) ImportContentPackage(fileName string, zip []byte, resolutionMode string) (*PackageOperationResult, error) {
+	return c.uploadContentPackage(PackagesAPI, map[string]string{"resolutionMode": resolutionMode}, fileName, zip)
+}
+
+func (c *APIClient) uploadContentPackage(path string, queryParameters map[string]string, fileName string, zip []byte) (*PackageOperationResult, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(zip); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	url := c.BuildEncodedURL(path, queryParameters)
+	resp, respErr := c.Post(url, body, map[string]string{
+		ContentTypeHeader: writer.FormDataContentType(),
+		AcceptHeader:      AppJSON})
+	if respErr != nil {
+		return nil, respErr
+	}
+	var result PackageOperationResult
+	if err := utils.UnmarshalJSON(resp.Body, &result); err != nil {
+		return nil, err
+	}
+	if resp.Location != "" {
+		result.PackageID = resp.Location[strings.LastIndex(resp.Location, "/")+1:]
+	}
+	return &result, nil
+}
+
+// GetContentPackage returns the package with the id
+func (c *APIClient) GetContentPackage(id string) (*ContentPackage, error) {
+	var contentPackage ContentPackage
+	if err := c.getObject(fmt.Sprintf(PackageAPI, id), nil, &contentPackage); err != nil {
+		return nil, err
+	}
+	return &contentPackage, nil
+}
+
+// CreateContentPackage creates the package of the contents and returns it with its id
+func (c *APIClient) CreateContentPackage(contentPackage *ContentPackage) (*ContentPackage, error) {
+	var created ContentPackage
+	if err := c.createObject(PackagesAPI, contentPackage, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// ExportContentPackage returns the zip of the package with the id
+func (c *APIClient) ExportContentPackage(id string) ([]byte, error) {
+	url := c.BuildEncodedURL(fmt.Sprintf(PackageAPI, id), nil)
+	resp, respErr := c.Get(url, map[string]string{AcceptHeader: AppZip})
+	if respErr != nil {
+		return nil, respErr
+	}
+	return resp.Body, nil
+}
+
+// DeleteContentPackage deletes the package with the id. The contents of the package are not deleted.
+func (c *APIClient) DeleteContentPackage(id string) error {
+	return c.deleteObject(fmt.Sprintf(PackageAPI, id))
+}
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestUploadContentPackage(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	var fileName, file string
+	upload := func(response, location string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			f, header, err := req.FormFile("file")
+			if err != nil {
+				return nil, err
+			}
+			data, _ := ioutil.ReadAll(f)
+			fileName, file = header.Filename, string(data)
+			resp := httpmock.NewStringResponse(200, response)
+			if location != "" {
+				resp.Header.Set("Location", location)
+			}
+			return resp, nil
+		}
+	}
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(ValidatePackageAPI, nil),
+		upload(packageValidationResponse, ""))
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(PackagesAPI, map[string]string{"resolutionMode": ResolutionModeOverwrite}),
+		upload(`{"operationType":"IMPORT","operationStatus":"SUCCESS","operationResults":[]}`,
+			client.BuildEncodedURL(fmt.Sprintf(PackageAPI, "7c8a4b3e-5d6f-4a1b-8c9d-0e1f2a3b4c5d"), nil)))
+
+	result, err := client.ValidateContentPackage("package.zip", []byte("zip"))
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "package.zip", fileName)
+	utils.AssertEqualsString(t, "zip", file)
+	utils.AssertTrue(t, "validation failed", result.Failed())
+	errors := result.Errors()
+	utils.AssertEqualsInt(t, 1, len(errors))
+	utils.AssertEqualsString(t, "composite-blueprint Basic Single Machine: The reservation policy Gold does not exist", errors[0])
+
+	utils.AssertEqualsString(t, "", result.PackageID)
+
+	result, err = client.ImportContentPackage("package.zip", []byte("zip"), ResolutionModeOverwrite)
+	utils.AssertNilError(t, err)
+	utils.AssertFalse(t, "import succeeded", result.Failed())
+	utils.AssertEqualsString(t, "7c8a4b3e-5d6f-4a1b-8c9d-0e1f2a3b4c5d", result.PackageID)
+}
+
+func TestExportContentPackage(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	httpmock.RegisterResponder("GET", client.BuildEncodedURL(ContentsAPI, map[string]string{
+		"$filter": "name eq 'Basic Single Machine' and contentTypeId eq 'composite-blueprint'",
+		"page":    "1"}),
+		httpmock.NewStringResponder(200, `{"content":[{"id":"c1","contentId":"BasicSingleMachine","name":"Basic Single Machine",
+			"contentTypeId":"composite-blueprint"}],"metadata":{"totalPages":1}}`))
+	contents, err := client.FindContents("composite-blueprint", "Basic Single Machine")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(contents))
+	utils.AssertEqualsString(t, "BasicSingleMachine", contents[0].ContentID)
+
+	id := "7c8a4b3e-5d6f-4a1b-8c9d-0e1f2a3b4c5d"
+	url := client.BuildEncodedURL(fmt.Sprintf(PackageAPI, id), nil)
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(PackagesAPI, nil),
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			if !strings.Contains(string(data), `"contents":["c1"]`) {
+				return httpmock.NewStringResponse(400, ""), nil
+			}
+			resp := httpmock.NewStringResponse(201, "")
+			resp.Header.Set("Location", url)
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", url,
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(AcceptHeader) == AppZip {
+				return httpmock.NewStringResponse(200, "zip"), nil
+			}
+			return httpmock.NewStringResponse(200, `{"id":"`+id+`","name":"promotion","contents":["c1"]}`), nil
+		})
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+
+	contentPackage, err := client.CreateContentPackage(&ContentPackage{Name: "promotion", Contents: []string{"c1"}})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, contentPackage.ID)
+	contentPackage, err = client.GetContentPackage(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "promotion", contentPackage.Name)
+	zip, err := client.ExportContentPackage(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "zip", string(zip))
+	utils.AssertNilError(t, client.DeleteContentPackage(id))
+}
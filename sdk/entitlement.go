@@ -0,0 +1,117 @@
+package sdk
+
+import (
+	"fmt"
+)
+
+// entitlement API constants
+const (
+	EntitlementsAPI = CatalogServiceAPI + "/entitlements"
+	EntitlementAPI  = EntitlementsAPI + "/%s"
+
+	// status of an entitlement
+	EntitlementStatusActive   = "ACTIVE"
+	EntitlementStatusInactive = "INACTIVE"
+	EntitlementStatusDraft    = "DRAFT"
+
+	// type of an entitlement principal
+	PrincipalTypeUser  = "USER"
+	PrincipalTypeGroup = "GROUP"
+
+	// type of an entitled resource operation
+	ResourceOperationTypeAction = "ACTION"
+)
+
+// Reference - reference to another vRA object, like a catalog item or a resource type
+type Reference struct {
+	ID    string `json:"id,omitempty"`
+	Label string `json:"label,omitempty"`
+}
+
+// Entitlement - entitlement of the users of a business group to catalog items and actions
+type Entitlement struct {
+	ID                         string                      `json:"id,omitempty"`
+	Name                       string                      `json:"name"`
+	Description                string                      `json:"description,omitempty"`
+	TenantRef                  string                      `json:"tenantRef,omitempty"`
+	Organization               EntitlementOrganization     `json:"organization"`
+	Status                     string                      `json:"status,omitempty"`
+	AllUsers                   bool                        `json:"allUsers"`
+	LocalScopeForActions       bool                        `json:"localScopeForActions"`
+	Principals                 []Principal                 `json:"principals"`
+	EntitledServices           []EntitledService           `json:"entitledServices"`
+	EntitledCatalogItems       []EntitledCatalogItem       `json:"entitledCatalogItems"`
+	EntitledResourceOperations []EntitledResourceOperation `json:"entitledResourceOperations"`
+	Version                    int                         `json:"version,omitempty"`
+}
+
+// EntitlementOrganization - tenant and business group of an entitlement
+type EntitlementOrganization struct {
+	TenantRef      string `json:"tenantRef,omitempty"`
+	TenantLabel    string `json:"tenantLabel,omitempty"`
+	SubtenantRef   string `json:"subtenantRef,omitempty"`
+	SubtenantLabel string `json:"subtenantLabel,omitempty"`
+}
+
+// Principal - user or group of the tenant
+type Principal struct {
+	TenantName string `json:"tenantName,omitempty"`
+	Ref        string `json:"ref"`
+	Type       string `json:"type"`
+	Value      string `json:"value,omitempty"`
+}
+
+// EntitledService - service whose catalog items are all entitled
+type EntitledService struct {
+	ServiceRef       Reference `json:"serviceRef"`
+	ApprovalPolicyID string    `json:"approvalPolicyId,omitempty"`
+	Active           bool      `json:"active"`
+}
+
+// EntitledCatalogItem - catalog item that is entitled
+type EntitledCatalogItem struct {
+	CatalogItemRef   Reference `json:"catalogItemRef"`
+	ApprovalPolicyID string    `json:"approvalPolicyId,omitempty"`
+	Active           bool      `json:"active"`
+}
+
+// EntitledResourceOperation - action that is entitled on the resources of a type
+type EntitledResourceOperation struct {
+	ExternalID            string    `json:"externalId"`
+	ResourceOperationType string    `json:"resourceOperationType,omitempty"`
+	TargetResourceTypeRef Reference `json:"targetResourceTypeRef"`
+	ApprovalPolicyID      string    `json:"approvalPolicyId,omitempty"`
+	Active                bool      `json:"active"`
+}
+
+// CreateEntitlement creates the entitlement and returns it with its id
+func (c *APIClient) CreateEntitlement(entitlement *Entitlement) (*Entitlement, error) {
+	var created Entitlement
+	if err := c.createObject(EntitlementsAPI, entitlement, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetEntitlement returns the entitlement with the id
+func (c *APIClient) GetEntitlement(id string) (*Entitlement, error) {
+	var entitlement Entitlement
+	if err := c.getObject(fmt.Sprintf(EntitlementAPI, id), nil, &entitlement); err != nil {
+		return nil, err
+	}
+	return &entitlement, nil
+}
+
+// UpdateEntitlement replaces the entitlement with the same id. The version has to be the current version of the entitlement.
+func (c *APIClient) UpdateEntitlement(entitlement *Entitlement) (*Entitlement, error) {
+	var updated Entitlement
+	if err := c.updateObject(fmt.Sprintf(EntitlementAPI, entitlement.ID), entitlement, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteEntitlement deletes the entitlement with the id
+func (c *APIClient) DeleteEntitlement(id string) error {
+	return c.deleteObject(fmt.Sprintf(EntitlementAPI, id))
+}
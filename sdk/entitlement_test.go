@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestEntitlement(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "b2b5c2a4-1f6e-4c1a-9a3b-0b1c1d2e3f40"
+	url := client.BuildEncodedURL(fmt.Sprintf(EntitlementAPI, id), nil)
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, entitlementResponse))
+
+	entitlement, err := client.GetEntitlement(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "Development", entitlement.Name)
+	utils.AssertEqualsString(t, "6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a", entitlement.Organization.SubtenantRef)
+	utils.AssertEqualsString(t, PrincipalTypeGroup, entitlement.Principals[0].Type)
+	utils.AssertEqualsString(t, "c0ffee00-0000-4000-8000-000000000002", entitlement.EntitledCatalogItems[0].ApprovalPolicyID)
+	utils.AssertEqualsString(t, "Infrastructure.Machine.Action.PowerOn", entitlement.EntitledResourceOperations[0].ExternalID)
+	utils.AssertEqualsInt(t, 3, entitlement.Version)
+
+	// the created entitlement is read from its location when the response has no body
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(EntitlementsAPI, nil),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(201, "")
+			resp.Header.Set("Location", url)
+			return resp, nil
+		})
+	created, err := client.CreateEntitlement(&Entitlement{Name: "Development"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, created.ID)
+
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(200, entitlementResponse))
+	updated, err := client.UpdateEntitlement(entitlement)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, updated.ID)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeleteEntitlement(id))
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(404, `{"errors":[{"code":20116,"message":"Entitlement not found"}]}`))
+	_, err = client.GetEntitlement(id)
+	utils.AssertNotNilError(t, err)
+	utils.AssertTrue(t, "not found error", IsNotFoundError(err))
+	utils.AssertFalse(t, "other error", IsNotFoundError(fmt.Errorf("error")))
+}
@@ -0,0 +1,160 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// types of the literal values of the extension data
+const (
+	LiteralTypeString    = "string"
+	LiteralTypeInteger   = "integer"
+	LiteralTypeBoolean   = "boolean"
+	LiteralTypeEntityRef = "entityRef"
+	LiteralTypeComplex   = "complex"
+	LiteralTypeMultiple  = "multiple"
+)
+
+// ExtensionData - typed entries of the extension data of a reservation
+type ExtensionData struct {
+	Entries []ExtensionDataEntry `json:"entries"`
+}
+
+// ExtensionDataEntry - key and typed value of an entry of the extension data
+type ExtensionDataEntry struct {
+	Key   string        `json:"key"`
+	Value *LiteralValue `json:"value"`
+}
+
+// LiteralValue - typed value of the extension data and of the properties: a string, integer or boolean value,
+// a reference to an entity, a complex value with entries or multiple values
+type LiteralValue struct {
+	Type            string          `json:"type"`
+	Value           interface{}     `json:"value,omitempty"`
+	ClassID         string          `json:"classId,omitempty"`
+	ComponentTypeID string          `json:"componentTypeId,omitempty"`
+	ID              string          `json:"id,omitempty"`
+	Label           string          `json:"label,omitempty"`
+	ElementTypeID   string          `json:"elementTypeId,omitempty"`
+	Items           []*LiteralValue `json:"items,omitempty"`
+	Values          *ExtensionData  `json:"values,omitempty"`
+}
+
+// NewIntegerValue returns the literal value of the integer
+func NewIntegerValue(value int) *LiteralValue {
+	return &LiteralValue{Type: LiteralTypeInteger, Value: value}
+}
+
+// NewBooleanValue returns the literal value of the boolean
+func NewBooleanValue(value bool) *LiteralValue {
+	return &LiteralValue{Type: LiteralTypeBoolean, Value: value}
+}
+
+// NewEntityRef returns the literal reference to the entity of the class with the id
+func NewEntityRef(classID, id string) *LiteralValue {
+	return &LiteralValue{Type: LiteralTypeEntityRef, ClassID: classID, ID: id}
+}
+
+// NewComplexValue returns the literal value of the class with the entries
+func NewComplexValue(classID string, entries ...ExtensionDataEntry) *LiteralValue {
+	return &LiteralValue{Type: LiteralTypeComplex, ClassID: classID, Values: &ExtensionData{Entries: entries}}
+}
+
+// NewMultipleValue returns the literal value of the items
+func NewMultipleValue(items []*LiteralValue) *LiteralValue {
+	return &LiteralValue{Type: LiteralTypeMultiple, ElementTypeID: "COMPLEX", Items: items}
+}
+
+// Int returns the integer value, or 0 if the value is not a number
+func (v *LiteralValue) Int() int {
+	if v == nil {
+		return 0
+	}
+	switch value := v.Value.(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	}
+	return 0
+}
+
+// Bool returns the boolean value, or false if the value is not a boolean
+func (v *LiteralValue) Bool() bool {
+	if v == nil {
+		return false
+	}
+	value, _ := v.Value.(bool)
+	return value
+}
+
+// Float returns the decimal value, or 0 if the value is not a number
+func (v *LiteralValue) Float() float64 {
+	if v == nil {
+		return 0
+	}
+	switch value := v.Value.(type) {
+	case float64:
+		return value
+	case int:
+		return float64(value)
+	}
+	return 0
+}
+
+// String returns the value as it is written in the configuration, or an empty string if there is no value
+func (v *LiteralValue) String() string {
+	if v == nil || v.Value == nil {
+		return ""
+	}
+	switch value := v.Value.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return fmt.Sprint(v.Value)
+}
+
+// EntityID returns the id of the referenced entity
+func (v *LiteralValue) EntityID() string {
+	if v == nil {
+		return ""
+	}
+	return v.ID
+}
+
+// Get returns the value of the key, or nil if the key is not in the extension data
+func (e *ExtensionData) Get(key string) *LiteralValue {
+	if e == nil {
+		return nil
+	}
+	for _, entry := range e.Entries {
+		if entry.Key == key {
+			return entry.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value of the key, or adds the key if it is not in the extension data
+func (e *ExtensionData) Set(key string, value *LiteralValue) {
+	for i, entry := range e.Entries {
+		if entry.Key == key {
+			e.Entries[i].Value = value
+			return
+		}
+	}
+	e.Entries = append(e.Entries, ExtensionDataEntry{Key: key, Value: value})
+}
+
+// Remove removes the key from the extension data
+func (e *ExtensionData) Remove(key string) {
+	entries := e.Entries[:0]
+	for _, entry := range e.Entries {
+		if entry.Key != key {
+			entries = append(entries, entry)
+		}
+	}
+	e.Entries = entries
+}
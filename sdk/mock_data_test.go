@@ -2651,4 +2651,413 @@ var (
 		   }
 		]
 	 }`
+
+	requestSchemaResponse = `{
+		"classId":"Blueprint.Component.Declaration",
+		"typeFilter":null,
+		"fields":[
+		   {
+			  "id":"_leaseDays",
+			  "label":"Lease days",
+			  "dataType":{
+				 "type":"primitive",
+				 "typeId":"INTEGER"
+			  },
+			  "state":{
+				 "dependencies":[],
+				 "facets":[
+					{
+					   "type":"minValue",
+					   "value":{
+						  "type":"constant",
+						  "value":{
+							 "type":"integer",
+							 "value":1
+						  }
+					   }
+					},
+					{
+					   "type":"maxValue",
+					   "value":{
+						  "type":"constant",
+						  "value":{
+							 "type":"integer",
+							 "value":30
+						  }
+					   }
+					}
+				 ]
+			  }
+		   },
+		   {
+			  "id":"machine2.vsphere",
+			  "label":"machine2.vsphere",
+			  "dataType":{
+				 "type":"complex",
+				 "classId":"Blueprint.Component.Declaration",
+				 "schema":{
+					"fields":[
+					   {
+						  "id":"_cluster",
+						  "label":"Instances",
+						  "dataType":{
+							 "type":"primitive",
+							 "typeId":"INTEGER"
+						  },
+						  "state":{
+							 "dependencies":[],
+							 "facets":[
+								{
+								   "type":"minValue",
+								   "value":{
+									  "type":"constant",
+									  "value":{
+										 "type":"integer",
+										 "value":1
+									  }
+								   }
+								},
+								{
+								   "type":"maxValue",
+								   "value":{
+									  "type":"constant",
+									  "value":{
+										 "type":"integer",
+										 "value":3
+									  }
+								   }
+								}
+							 ]
+						  }
+					   }
+					]
+				 }
+			  },
+			  "state":{
+				 "dependencies":[],
+				 "facets":[]
+			  }
+		   }
+		]
+	 }`
+
+	catalogRequestsResponse = `{
+		"links":[],
+		"content":[
+			{
+				"@type":"CatalogItemRequest",
+				"id":"7aaf9baf-aa4e-47c4-997b-edd7c7983a5b",
+				"iconId":"feaedf73-560c-4612-a573-41667e017691",
+				"version":6,
+				"requestNumber":1216,
+				"state":"SUCCESSFUL",
+				"description":"web servers [tf-4b0d2f6c8a1e9d3b7f52]",
+				"reasons":null,
+				"requestedFor":"admin@vsphere.local",
+				"requestedBy":"admin@vsphere.local",
+				"dateCreated":"2019-05-07T19:02:16.924Z",
+				"lastUpdated":"2019-05-07T19:06:22.467Z",
+				"dateSubmitted":"2019-05-07T19:02:16.924Z",
+				"phase":"SUCCESSFUL"
+			}
+		],
+		"metadata":{
+			"size":20,
+			"totalElements":1,
+			"totalPages":1,
+			"number":1,
+			"offset":0
+		}
+	}`
+
+	deploymentResourcesResponse = `{
+		"links":[],
+		"content":[
+			{
+				"@type":"ConsumerResource",
+				"id":"0dd9d9d9-a4a0-4c8c-8e5e-8bd1c3f5e6a7",
+				"name":"web-servers",
+				"resourceTypeRef":{
+					"id":"composition.resource.type.deployment",
+					"label":"Deployment"
+				},
+				"status":"ACTIVE",
+				"catalogItem":{
+					"id":"feaedf73-560c-4612-a573-41667e017691",
+					"label":"CentOS 7.0 x64"
+				},
+				"requestId":"7aaf9baf-aa4e-47c4-997b-edd7c7983a5b",
+				"organization":{
+					"tenantRef":"vsphere.local",
+					"tenantLabel":"vsphere.local",
+					"subtenantRef":"6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+					"subtenantLabel":"Development"
+				}
+			}
+		],
+		"metadata":{
+			"size":20,
+			"totalElements":1,
+			"totalPages":1,
+			"number":1,
+			"offset":0
+		}
+	}`
+
+	entitlementResponse = `{
+		"id":"b2b5c2a4-1f6e-4c1a-9a3b-0b1c1d2e3f40",
+		"name":"Development",
+		"description":"Catalog of the development team",
+		"tenantRef":"vsphere.local",
+		"organization":{
+			"tenantRef":"vsphere.local",
+			"tenantLabel":"vsphere.local",
+			"subtenantRef":"6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+			"subtenantLabel":"Development"
+		},
+		"principals":[
+			{"tenantName":"vsphere.local","ref":"developers@example.com","type":"GROUP","value":"developers"}
+		],
+		"status":"ACTIVE",
+		"statusName":"Active",
+		"allUsers":false,
+		"localScopeForActions":true,
+		"entitledServices":[
+			{"serviceRef":{"id":"a1b2c3d4-0000-4000-8000-000000000001","label":"Linux"},"approvalPolicyId":null,"active":true}
+		],
+		"entitledCatalogItems":[
+			{"catalogItemRef":{"id":"feaedf73-560c-4612-a573-41667e017691","label":"CentOS 7.0 x64"},"approvalPolicyId":"c0ffee00-0000-4000-8000-000000000002","active":true}
+		],
+		"entitledResourceOperations":[
+			{"resourceOperationRef":{"id":"3f4e5d6c","label":"Power On"},"externalId":"Infrastructure.Machine.Action.PowerOn","resourceOperationType":"ACTION","targetResourceTypeRef":{"id":"Infrastructure.Virtual","label":"Virtual Machine"},"approvalPolicyId":null,"active":true}
+		],
+		"version":3
+	}`
+
+	catalogServiceResponse = `{
+		"id":"a1b2c3d4-0000-4000-8000-000000000001",
+		"name":"Linux",
+		"description":"Linux machines",
+		"status":"ACTIVE",
+		"statusName":"Active",
+		"organization":{"tenantRef":"vsphere.local","tenantLabel":"vsphere.local","subtenantRef":null,"subtenantLabel":null},
+		"owner":{"tenantName":"vsphere.local","ref":"jdoe@example.com","type":"USER","value":"John Doe"},
+		"supportTeam":{"tenantName":"vsphere.local","ref":"linux-support@example.com","type":"GROUP","value":"Linux Support"},
+		"hours":{"startTime":"08:00","endTime":"18:00"},
+		"changeWindow":null,
+		"newDuration":null,
+		"version":2
+	}`
+
+	serviceCatalogItemsPage1Response = `{
+		"links":[],
+		"content":[
+			{"@type":"CatalogItem","id":"feaedf73-560c-4612-a573-41667e017691","name":"CentOS 7.0 x64","status":"PUBLISHED",
+			"serviceRef":{"id":"a1b2c3d4-0000-4000-8000-000000000001","label":"Linux"}}
+		],
+		"metadata":{"size":1,"totalElements":2,"totalPages":2,"number":1,"offset":0}
+	}`
+
+	serviceCatalogItemsPage2Response = `{
+		"links":[],
+		"content":[
+			{"@type":"CatalogItem","id":"0b4e0bd8-f1b6-4b60-9d4a-5f6f7e3a2c11","name":"Ubuntu 18.04","status":"PUBLISHED",
+			"serviceRef":{"id":"a1b2c3d4-0000-4000-8000-000000000001","label":"Linux"}}
+		],
+		"metadata":{"size":1,"totalElements":2,"totalPages":2,"number":2,"offset":1}
+	}`
+
+	blueprintDocumentResponse = `{
+		"@type":"CompositeBlueprint",
+		"id":"BasicSingleMachine",
+		"name":"Basic Single Machine",
+		"description":"Basic vSphere Machine",
+		"status":"PUBLISHED",
+		"createdDate":"2019-03-25T20:33:49.123Z",
+		"lastUpdated":"2019-03-26T08:12:01.456Z",
+		"properties":{"_leaseDays":{"default":1,"max":60,"min":1}},
+		"components":{
+			"vSphereVM1":{
+				"type":"Infrastructure.CatalogItem.Machine.Virtual.vSphere",
+				"data":{
+					"cpu":{"default":1,"max":4,"min":1},
+					"memory":{"default":1240,"max":8192,"min":1240},
+					"nics":[{"id":0,"network":"${_resource~DefaultNetworkProfile}","assignment_type":"Static"}]
+				}
+			}
+		},
+		"layout":{"vSphereVM1":"0,1"}
+	}`
+
+	packageValidationResponse = `{
+		"operationType":"VALIDATE",
+		"operationStatus":"FAILED",
+		"operationResults":[
+			{"contentId":"BasicSingleMachine","contentName":"Basic Single Machine","contentTypeId":"composite-blueprint",
+			"operationStatusType":"FAILED","messages":null,"operationErrors":["The reservation policy Gold does not exist"]},
+			{"contentId":"vm-size","contentName":"VM size","contentTypeId":"property-definition",
+			"operationStatusType":"SUCCESS","messages":["The content already exists"],"operationErrors":null}
+		]
+	}`
+
+	reservationResponse = `{
+		"id":"5e1a6c2f-8d3b-4a7e-9f10-2b3c4d5e6f70",
+		"name":"Development-vSphere",
+		"reservationTypeId":"Infrastructure.Reservation.Virtual.vSphere",
+		"tenantId":"vsphere.local",
+		"subTenantId":"6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+		"enabled":true,
+		"priority":2,
+		"reservationPolicyId":"d1e2f3a4-0000-4000-8000-000000000003",
+		"alertPolicy":{"enabled":true,"frequencyReminder":7,"emailBgMgr":true,"recipients":["ops@example.com"],
+			"alerts":[{"id":"storage","referenceResourceId":"storage","alertPercentLevel":90},
+				{"id":"memory","referenceResourceId":"memory","alertPercentLevel":85},
+				{"id":"cpu","referenceResourceId":"cpu","alertPercentLevel":80},
+				{"id":"machine","referenceResourceId":"machine","alertPercentLevel":75}]},
+		"extensionData":{"entries":[
+			{"key":"computeResource","value":{"type":"entityRef","classId":"ComputeResource","id":"cr-1","label":"Cluster1"}},
+			{"key":"machineQuota","value":{"type":"integer","value":10}},
+			{"key":"reservationMemory","value":{"type":"complex","classId":"Infrastructure.Reservation.Memory","values":{"entries":[
+				{"key":"computeResourceMemoryTotalSizeMb","value":{"type":"integer","value":65536}},
+				{"key":"memoryReservedSizeMb","value":{"type":"integer","value":8192}}]}}},
+			{"key":"reservationStorages","value":{"type":"multiple","elementTypeId":"COMPLEX","items":[
+				{"type":"complex","classId":"Infrastructure.Reservation.Storage","values":{"entries":[
+					{"key":"storagePath","value":{"type":"entityRef","classId":"Storage","id":"ds-1","label":"datastore1"}},
+					{"key":"storageReservedSizeGB","value":{"type":"integer","value":100}},
+					{"key":"storageReservationPriority","value":{"type":"integer","value":1}},
+					{"key":"storageEnabled","value":{"type":"boolean","value":false}}]}}]}},
+			{"key":"reservationNetworks","value":{"type":"multiple","elementTypeId":"COMPLEX","items":[
+				{"type":"complex","classId":"Infrastructure.Reservation.Network","values":{"entries":[
+					{"key":"networkPath","value":{"type":"entityRef","classId":"Network","id":"net-1","label":"VM Network"}},
+					{"key":"networkProfile","value":{"type":"entityRef","classId":"NetworkProfile","id":"np-1","label":"Default"}}]}}]}},
+			{"key":"reservationVCNSTransportZone","value":null}
+		]},
+		"version":4
+	}`
+
+	reservationPoliciesResponse = `{
+		"links":[],
+		"content":[
+			{"id":"d1e2f3a4-0000-4000-8000-000000000003","name":"Gold","description":"Gold clusters",
+			"reservationPolicyTypeId":"Infrastructure.Reservation.Policy.ComputeResource","tenantId":"vsphere.local"},
+			{"id":"e2f3a4b5-0000-4000-8000-000000000004","name":"Gold","description":"Gold datastores",
+			"reservationPolicyTypeId":"Infrastructure.Reservation.Policy.Storage","tenantId":"vsphere.local"}
+		],
+		"metadata":{"size":20,"totalElements":2,"totalPages":1,"number":1,"offset":0}
+	}`
+
+	networkProfileResponse = `{
+		"@type":"ExternalNetworkProfile",
+		"id":"3e5f7091-0000-4000-8000-000000000030",
+		"name":"Development",
+		"description":"Development network",
+		"createdDate":"2019-04-01T10:00:00.000Z",
+		"lastModifiedDate":"2019-04-02T10:00:00.000Z",
+		"isHidden":false,
+		"profileType":"EXTERNAL",
+		"IPAMEndpointId":"1",
+		"addressSpaceExternalId":"default",
+		"subnetMask":"255.255.255.0",
+		"gatewayAddress":"10.10.0.1",
+		"primaryDnsAddress":"10.10.0.2",
+		"secondaryDnsAddress":null,
+		"dnsSuffix":"dev.example.com",
+		"dnsSearchSuffix":null,
+		"primaryWinsAddress":null,
+		"secondaryWinsAddress":null,
+		"definedRanges":[
+			{"id":"r-1","name":"servers","description":"","beginIPv4Address":"10.10.0.10","endIPv4Address":"10.10.0.19","state":"UNALLOCATED",
+			"definedAddresses":[
+				{"id":"a-1","IPv4Address":"10.10.0.10","state":"ALLOCATED","virtualMachineName":"dev-web-01"},
+				{"id":"a-2","IPv4Address":"10.10.0.11","state":"UNALLOCATED"},
+				{"id":"a-3","IPv4Address":"10.10.0.12","state":"ALLOCATED","virtualMachineName":"dev-web-02"}
+			]},
+			{"id":"r-2","name":"desktops","description":"VDI","beginIPv4Address":"10.10.0.100","endIPv4Address":"10.10.0.199","state":"UNALLOCATED",
+			"definedAddresses":[]}
+		]
+	}`
+
+	propertyDefinitionResponse = `{
+		"id":"VirtualMachine.Network0.Name",
+		"name":"VirtualMachine.Network0.Name",
+		"label":"Network",
+		"description":"Network of the first NIC",
+		"tenantId":"vsphere.local",
+		"dataType":{"type":"primitive","typeId":"STRING"},
+		"displayAdvice":"DROPDOWN",
+		"isMultiValued":false,
+		"orderIndex":2,
+		"facets":{
+			"mandatory":{"type":"constantClause","value":{"type":"boolean","value":true}},
+			"permissibleValues":{"type":"staticClause","value":[
+				{"underlyingValue":{"type":"string","value":"dev-net"},"label":"Development"},
+				{"underlyingValue":{"type":"string","value":"prod-net"},"label":"Production"}
+			]}
+		},
+		"version":1
+	}`
+
+	externalPropertyDefinitionResponse = `{
+		"id":"Custom.Datastore",
+		"name":"Custom.Datastore",
+		"label":"Datastore",
+		"tenantId":"vsphere.local",
+		"dataType":{"type":"primitive","typeId":"STRING"},
+		"displayAdvice":"DROPDOWN",
+		"isMultiValued":false,
+		"facets":{
+			"permissibleValues":{"type":"externalClause","value":{"id":"com.example.storage/getDatastores",
+				"parameters":[{"name":"site","value":{"type":"constantClause","value":{"type":"string","value":"paris"}}}]}}
+		}
+	}`
+
+	propertyGroupResponse = `{
+		"id":"WebServers",
+		"name":"WebServers",
+		"label":"Web servers",
+		"description":"Properties of the web servers",
+		"tenantId":"vsphere.local",
+		"properties":{
+			"Custom.Role":{"defaultValue":{"type":"string","value":"web"},"encrypted":false,"visible":true},
+			"Custom.Password":{"defaultValue":{"type":"secureString","value":"********"},"encrypted":true,"visible":false}
+		}
+	}`
+
+	approvalPolicyResponse = `{
+		"id":"7a8b9c0d-0000-4000-8000-000000000050",
+		"name":"Production approval",
+		"description":"Approval of the production catalog items",
+		"tenantId":"vsphere.local",
+		"policyTypeId":"com.vmware.cafe.catalog.request.pre",
+		"state":"ACTIVE",
+		"phases":[
+			{"id":"p-1","name":"Pre Approval","phaseTypeId":"com.vmware.cafe.catalog.request.pre",
+			"levels":[
+				{"id":"l-2","name":"Security","levelNumber":2,"approvalMode":"ALL","approverType":"SPECIFIC_USERS_GROUPS",
+				"approvers":[{"tenantName":"vsphere.local","ref":"security@example.com","type":"GROUP"}],
+				"alwaysRequired":false,
+				"condition":{"type":"or","subClauses":[
+					{"type":"expression","operator":"greaterThan","leftOperand":{"type":"path","path":"~cpu"},
+					"rightOperand":{"type":"constant","value":{"type":"string","value":"4"}}},
+					{"type":"expression","operator":"equals","leftOperand":{"type":"path","path":"~environment"},
+					"rightOperand":{"type":"constant","value":{"type":"string","value":"production"}}}
+				]}},
+				{"id":"l-1","name":"Manager","levelNumber":1,"approvalMode":"ANY","approverType":"SPECIFIC_USERS_GROUPS",
+				"approvers":[{"tenantName":"vsphere.local","ref":"jane@example.com","type":"USER"},
+					{"tenantName":"vsphere.local","ref":"john@example.com","type":"USER"}],
+				"alwaysRequired":true}
+			]},
+			{"id":"p-2","name":"Post Approval","phaseTypeId":"com.vmware.cafe.catalog.request.post","levels":[]}
+		],
+		"version":3
+	}`
+
+	approvalPoliciesResponse = `{
+		"links":[],
+		"content":[
+			{"id":"7a8b9c0d-0000-4000-8000-000000000050","name":"Production approval","policyTypeId":"com.vmware.cafe.catalog.request.pre","state":"ACTIVE","phases":[]},
+			{"id":"8b9c0d1e-0000-4000-8000-000000000051","name":"Production approval (copy)","policyTypeId":"com.vmware.cafe.catalog.request.pre","state":"DRAFT","phases":[]}
+		],
+		"metadata":{"size":20,"totalElements":2,"totalPages":1,"number":1,"offset":0}
+	}`
 )
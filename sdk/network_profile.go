@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"fmt"
+)
+
+// network profile API constants
+const (
+	IaaSProxyProviderAPI = "/iaas-proxy-provider/api"
+	NetworkProfilesAPI   = IaaSProxyProviderAPI + "/network/profiles"
+	NetworkProfileAPI    = NetworkProfilesAPI + "/%s"
+
+	// types of the network profiles
+	NetworkProfileTypeExternal = "EXTERNAL"
+	NetworkProfileTypeNAT      = "NAT"
+	NetworkProfileTypeRouted   = "ROUTED"
+
+	// NAT types of a NAT network profile
+	NATTypeOneToOne  = "ONETOONE"
+	NATTypeOneToMany = "ONETOMANY"
+
+	// state of an IP address of a network profile
+	IPAddressStateAllocated   = "ALLOCATED"
+	IPAddressStateUnallocated = "UNALLOCATED"
+	IPAddressStateExpired     = "EXPIRED"
+	IPAddressStateDestroyed   = "DESTROYED"
+)
+
+// networkProfileClasses are the types of the JSON documents of the network profile types
+var networkProfileClasses = map[string]string{
+	NetworkProfileTypeExternal: "ExternalNetworkProfile",
+	NetworkProfileTypeNAT:      "NATNetworkProfile",
+	NetworkProfileTypeRouted:   "RoutedNetworkProfile",
+}
+
+// IaaSNetworkProfile - IP addressing of the machines provisioned on a network
+type IaaSNetworkProfile struct {
+	Class                    string         `json:"@type"`
+	ID                       string         `json:"id,omitempty"`
+	Name                     string         `json:"name"`
+	Description              string         `json:"description,omitempty"`
+	ProfileType              string         `json:"profileType"`
+	IsHidden                 bool           `json:"isHidden"`
+	SubnetMask               string         `json:"subnetMask"`
+	GatewayAddress           string         `json:"gatewayAddress,omitempty"`
+	PrimaryDNSAddress        string         `json:"primaryDnsAddress,omitempty"`
+	SecondaryDNSAddress      string         `json:"secondaryDnsAddress,omitempty"`
+	DNSSuffix                string         `json:"dnsSuffix,omitempty"`
+	DNSSearchSuffix          string         `json:"dnsSearchSuffix,omitempty"`
+	PrimaryWinsAddress       string         `json:"primaryWinsAddress,omitempty"`
+	SecondaryWinsAddress     string         `json:"secondaryWinsAddress,omitempty"`
+	ExternalNetworkProfileID string         `json:"externalNetworkProfileId,omitempty"`
+	NATType                  string         `json:"natType,omitempty"`
+	RangeSubnetMask          string         `json:"rangeSubnetMask,omitempty"`
+	BaseIP                   string         `json:"baseIP,omitempty"`
+	DefinedRanges            []NetworkRange `json:"definedRanges"`
+}
+
+// NetworkRange - range of the static IP addresses of a network profile
+type NetworkRange struct {
+	ID               string             `json:"id,omitempty"`
+	Name             string             `json:"name"`
+	Description      string             `json:"description,omitempty"`
+	BeginIPv4Address string             `json:"beginIPv4Address"`
+	EndIPv4Address   string             `json:"endIPv4Address"`
+	State            string             `json:"state,omitempty"`
+	DefinedAddresses []NetworkIPAddress `json:"definedAddresses,omitempty"`
+}
+
+// NetworkIPAddress - IP address of a range, and the machine it is allocated to
+type NetworkIPAddress struct {
+	ID                 string `json:"id,omitempty"`
+	IPv4Address        string `json:"IPv4Address"`
+	State              string `json:"state,omitempty"`
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+}
+
+// NewNetworkProfile returns a network profile of the type
+func NewNetworkProfile(profileType string) *IaaSNetworkProfile {
+	return &IaaSNetworkProfile{Class: networkProfileClasses[profileType], ProfileType: profileType}
+}
+
+// CreateNetworkProfile creates the network profile and returns it with its id
+func (c *APIClient) CreateNetworkProfile(profile *IaaSNetworkProfile) (*IaaSNetworkProfile, error) {
+	var created IaaSNetworkProfile
+	if err := c.createObject(NetworkProfilesAPI, profile, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetNetworkProfile returns the network profile with the id, with the IP addresses of its ranges
+func (c *APIClient) GetNetworkProfile(id string) (*IaaSNetworkProfile, error) {
+	var profile IaaSNetworkProfile
+	if err := c.getObject(fmt.Sprintf(NetworkProfileAPI, id), nil, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// UpdateNetworkProfile replaces the network profile with the same id
+func (c *APIClient) UpdateNetworkProfile(profile *IaaSNetworkProfile) (*IaaSNetworkProfile, error) {
+	var updated IaaSNetworkProfile
+	if err := c.updateObject(fmt.Sprintf(NetworkProfileAPI, profile.ID), profile, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteNetworkProfile deletes the network profile with the id
+func (c *APIClient) DeleteNetworkProfile(id string) error {
+	return c.deleteObject(fmt.Sprintf(NetworkProfileAPI, id))
+}
@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestNetworkProfile(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "3e5f7091-0000-4000-8000-000000000030"
+	url := client.BuildEncodedURL(fmt.Sprintf(NetworkProfileAPI, id), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, networkProfileResponse))
+
+	profile, err := client.GetNetworkProfile(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, NetworkProfileTypeExternal, profile.ProfileType)
+	utils.AssertEqualsString(t, "10.10.0.1", profile.GatewayAddress)
+	utils.AssertEqualsInt(t, 2, len(profile.DefinedRanges))
+	utils.AssertEqualsString(t, "dev-web-01", profile.DefinedRanges[0].DefinedAddresses[0].VirtualMachineName)
+
+	// the type of the JSON document is the one of the profile type
+	var class string
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(NetworkProfilesAPI, nil),
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			var body map[string]interface{}
+			if err := utils.UnmarshalJSON(data, &body); err != nil {
+				return nil, err
+			}
+			class = body["@type"].(string)
+			return httpmock.NewStringResponse(201, networkProfileResponse), nil
+		})
+	created, err := client.CreateNetworkProfile(NewNetworkProfile(NetworkProfileTypeNAT))
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "NATNetworkProfile", class)
+	utils.AssertEqualsString(t, id, created.ID)
+
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(204, ""))
+	updated, err := client.UpdateNetworkProfile(profile)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, updated.ID)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeleteNetworkProfile(id))
+}
@@ -0,0 +1,243 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// property definition API constants
+const (
+	PropertiesServiceAPI   = "/properties-service/api"
+	PropertyDefinitionsAPI = PropertiesServiceAPI + "/propertydefinitions"
+	PropertyDefinitionAPI  = PropertyDefinitionsAPI + "/%s"
+
+	// data types of a property definition
+	PropertyDataTypeString       = "STRING"
+	PropertyDataTypeInteger      = "INTEGER"
+	PropertyDataTypeDecimal      = "DECIMAL"
+	PropertyDataTypeBoolean      = "BOOLEAN"
+	PropertyDataTypeSecureString = SecureStringType
+
+	// how the value of a property definition is entered in the request form
+	DisplayAdviceTextBox  = "TEXTBOX"
+	DisplayAdviceTextArea = "TEXTAREA"
+	DisplayAdviceDropDown = "DROPDOWN"
+	DisplayAdviceSlider   = "SLIDER"
+	DisplayAdviceCheckBox = "CHECKBOX"
+	DisplayAdviceYesNo    = "YES_NO"
+	DisplayAdviceEmail    = "EMAIL"
+
+	// constraints of the values of a property definition
+	MandatoryFacet         = "mandatory"
+	IncrementFacet         = "increment"
+	MinLengthFacet         = "minLength"
+	MaxLengthFacet         = "maxLength"
+	PermissibleValuesFacet = "permissibleValues"
+
+	// types of the clauses of the facets: a constant value, a static list of values, or values returned
+	// by a vRO action
+	ConstantClause = "constantClause"
+	StaticClause   = "staticClause"
+	ExternalClause = "externalClause"
+
+	// types of the literal values of the property definitions, besides the ones of the extension data
+	LiteralTypeDecimal      = "decimal"
+	LiteralTypeSecureString = "secureString"
+
+	primitiveDataType = "primitive"
+)
+
+// propertyLiteralTypes are the types of the literal values of the data types of the property definitions
+var propertyLiteralTypes = map[string]string{
+	PropertyDataTypeString:       LiteralTypeString,
+	PropertyDataTypeInteger:      LiteralTypeInteger,
+	PropertyDataTypeDecimal:      LiteralTypeDecimal,
+	PropertyDataTypeBoolean:      LiteralTypeBoolean,
+	PropertyDataTypeSecureString: LiteralTypeSecureString,
+}
+
+// PropertyDefinition - data type, constraints and presentation of a custom property in the request forms
+type PropertyDefinition struct {
+	ID            string                     `json:"id,omitempty"`
+	Name          string                     `json:"name"`
+	Label         string                     `json:"label"`
+	Description   string                     `json:"description,omitempty"`
+	TenantID      string                     `json:"tenantId,omitempty"`
+	DataType      PropertyDataType           `json:"dataType"`
+	DisplayAdvice string                     `json:"displayAdvice,omitempty"`
+	IsMultiValued bool                       `json:"isMultiValued"`
+	OrderIndex    *int                       `json:"orderIndex,omitempty"`
+	Facets        map[string]*PropertyClause `json:"facets,omitempty"`
+	Version       int                        `json:"version,omitempty"`
+}
+
+// PropertyDataType - data type of a property definition
+type PropertyDataType struct {
+	Type   string `json:"type"`
+	TypeID string `json:"typeId"`
+}
+
+// PropertyClause - value of a facet: a constant *LiteralValue, the []PermissibleValue of a static list, or the
+// *ExternalValueSource of an external list
+type PropertyClause struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// PermissibleValue - value of a static list, and its label in the request form
+type PermissibleValue struct {
+	UnderlyingValue *LiteralValue `json:"underlyingValue"`
+	Label           string        `json:"label,omitempty"`
+}
+
+// ExternalValueSource - vRO action returning the values of an external list, for e.g., com.example/getNetworks
+type ExternalValueSource struct {
+	ID         string                   `json:"id"`
+	Parameters []ExternalValueParameter `json:"parameters,omitempty"`
+}
+
+// ExternalValueParameter - constant input parameter of the vRO action of an external list
+type ExternalValueParameter struct {
+	Name  string          `json:"name"`
+	Value *PropertyClause `json:"value"`
+}
+
+// NewPropertyDefinition returns a property definition of the data type
+func NewPropertyDefinition(name, dataType string) *PropertyDefinition {
+	return &PropertyDefinition{
+		Name:     name,
+		DataType: PropertyDataType{Type: primitiveDataType, TypeID: dataType},
+		Facets:   map[string]*PropertyClause{},
+	}
+}
+
+// NewConstantClause returns the clause of the constant value
+func NewConstantClause(value *LiteralValue) *PropertyClause {
+	return &PropertyClause{Type: ConstantClause, Value: value}
+}
+
+// NewPropertyValue returns the literal value of the data type of a property definition
+func NewPropertyValue(dataType string, value interface{}) *LiteralValue {
+	return &LiteralValue{Type: propertyLiteralTypes[dataType], Value: value}
+}
+
+// LiteralType returns the type of the literal values of the data type of the property definition
+func (p *PropertyDefinition) LiteralType() string {
+	return propertyLiteralTypes[p.DataType.TypeID]
+}
+
+// Constant returns the constant value of the facet, or nil if the property definition has no such facet
+func (p *PropertyDefinition) Constant(facetType string) *LiteralValue {
+	clause := p.Facets[facetType]
+	if clause == nil || clause.Type != ConstantClause {
+		return nil
+	}
+	value, _ := clause.Value.(*LiteralValue)
+	return value
+}
+
+// SetConstant sets the constant value of the facet, or removes the facet if the value is nil
+func (p *PropertyDefinition) SetConstant(facetType string, value *LiteralValue) {
+	if p.Facets == nil {
+		p.Facets = map[string]*PropertyClause{}
+	}
+	if value == nil {
+		delete(p.Facets, facetType)
+		return
+	}
+	p.Facets[facetType] = NewConstantClause(value)
+}
+
+// IsMandatory returns true if a value is required for the property
+func (p *PropertyDefinition) IsMandatory() bool {
+	return p.Constant(MandatoryFacet).Bool()
+}
+
+// StaticValues returns the values of the static list of the property definition, or nil if it has none
+func (p *PropertyDefinition) StaticValues() []PermissibleValue {
+	clause := p.Facets[PermissibleValuesFacet]
+	if clause == nil || clause.Type != StaticClause {
+		return nil
+	}
+	values, _ := clause.Value.([]PermissibleValue)
+	return values
+}
+
+// ExternalValues returns the source of the external list of the property definition, or nil if it has none
+func (p *PropertyDefinition) ExternalValues() *ExternalValueSource {
+	clause := p.Facets[PermissibleValuesFacet]
+	if clause == nil || clause.Type != ExternalClause {
+		return nil
+	}
+	source, _ := clause.Value.(*ExternalValueSource)
+	return source
+}
+
+// UnmarshalJSON reads the value of the clause with the Go type of the type of the clause
+func (c *PropertyClause) UnmarshalJSON(data []byte) error {
+	var clause struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &clause); err != nil {
+		return err
+	}
+	c.Type = clause.Type
+	var value interface{}
+	switch clause.Type {
+	case ConstantClause:
+		value = &LiteralValue{}
+	case StaticClause:
+		value = &[]PermissibleValue{}
+	case ExternalClause:
+		value = &ExternalValueSource{}
+	default:
+		value = new(interface{})
+	}
+	if len(clause.Value) > 0 {
+		if err := json.Unmarshal(clause.Value, value); err != nil {
+			return err
+		}
+	}
+	switch v := value.(type) {
+	case *[]PermissibleValue:
+		c.Value = *v
+	case *interface{}:
+		c.Value = *v
+	default:
+		c.Value = v
+	}
+	return nil
+}
+
+// CreatePropertyDefinition creates the property definition and returns it
+func (c *APIClient) CreatePropertyDefinition(definition *PropertyDefinition) (*PropertyDefinition, error) {
+	var created PropertyDefinition
+	if err := c.createObject(PropertyDefinitionsAPI, definition, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetPropertyDefinition returns the property definition with the name
+func (c *APIClient) GetPropertyDefinition(name string) (*PropertyDefinition, error) {
+	var definition PropertyDefinition
+	if err := c.getObject(fmt.Sprintf(PropertyDefinitionAPI, name), nil, &definition); err != nil {
+		return nil, err
+	}
+	return &definition, nil
+}
+
+// UpdatePropertyDefinition replaces the property definition with the same name
+func (c *APIClient) UpdatePropertyDefinition(definition *PropertyDefinition) (*PropertyDefinition, error) {
+	var updated PropertyDefinition
+	if err := c.updateObject(fmt.Sprintf(PropertyDefinitionAPI, definition.Name), definition, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeletePropertyDefinition deletes the property definition with the name
+func (c *APIClient) DeletePropertyDefinition(name string) error {
+	return c.deleteObject(fmt.Sprintf(PropertyDefinitionAPI, name))
+}
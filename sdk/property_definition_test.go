@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestPropertyDefinition(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	name := "VirtualMachine.Network0.Name"
+	url := client.BuildEncodedURL(fmt.Sprintf(PropertyDefinitionAPI, name), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, propertyDefinitionResponse))
+
+	definition, err := client.GetPropertyDefinition(name)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, PropertyDataTypeString, definition.DataType.TypeID)
+	utils.AssertEqualsString(t, LiteralTypeString, definition.LiteralType())
+	utils.AssertEqualsInt(t, 2, *definition.OrderIndex)
+	utils.AssertTrue(t, "mandatory", definition.IsMandatory())
+	utils.AssertTrue(t, "no external list", definition.ExternalValues() == nil)
+	values := definition.StaticValues()
+	utils.AssertEqualsInt(t, 2, len(values))
+	utils.AssertEqualsString(t, "prod-net", values[1].UnderlyingValue.String())
+	utils.AssertEqualsString(t, "Production", values[1].Label)
+
+	// the facets are sent back as they were read
+	var facets map[string]interface{}
+	httpmock.RegisterResponder("PUT", url,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			var body map[string]interface{}
+			if err := utils.UnmarshalJSON(data, &body); err != nil {
+				return nil, err
+			}
+			facets = body["facets"].(map[string]interface{})
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	definition.SetConstant(MandatoryFacet, nil)
+	_, err = client.UpdatePropertyDefinition(definition)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(facets))
+	permissibleValues := facets[PermissibleValuesFacet].(map[string]interface{})
+	utils.AssertEqualsString(t, StaticClause, permissibleValues["type"].(string))
+	utils.AssertEqualsInt(t, 2, len(permissibleValues["value"].([]interface{})))
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(PropertyDefinitionsAPI, nil),
+		httpmock.NewStringResponder(201, propertyDefinitionResponse))
+	created, err := client.CreatePropertyDefinition(NewPropertyDefinition(name, PropertyDataTypeString))
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, name, created.Name)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeletePropertyDefinition(name))
+}
+
+func TestExternalPropertyDefinition(t *testing.T) {
+	var definition PropertyDefinition
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(externalPropertyDefinitionResponse), &definition))
+	utils.AssertFalse(t, "not mandatory", definition.IsMandatory())
+	utils.AssertTrue(t, "no static list", definition.StaticValues() == nil)
+	source := definition.ExternalValues()
+	utils.AssertTrue(t, "external list", source != nil)
+	utils.AssertEqualsString(t, "com.example.storage/getDatastores", source.ID)
+	utils.AssertEqualsString(t, "site", source.Parameters[0].Name)
+	utils.AssertEqualsString(t, "paris", source.Parameters[0].Value.Value.(*LiteralValue).String())
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"fmt"
+)
+
+// property group API constants
+const (
+	PropertyGroupsAPI = PropertiesServiceAPI + "/propertygroups"
+	PropertyGroupAPI  = PropertyGroupsAPI + "/%s"
+)
+
+// PropertyGroup - custom properties that are added together to the blueprints, by name
+type PropertyGroup struct {
+	ID          string                    `json:"id,omitempty"`
+	Name        string                    `json:"name"`
+	Label       string                    `json:"label"`
+	Description string                    `json:"description,omitempty"`
+	TenantID    string                    `json:"tenantId,omitempty"`
+	Properties  map[string]*GroupProperty `json:"properties"`
+	Version     int                       `json:"version,omitempty"`
+}
+
+// GroupProperty - value of a custom property of a property group
+type GroupProperty struct {
+	DefaultValue *LiteralValue `json:"defaultValue,omitempty"`
+	Encrypted    bool          `json:"encrypted"`
+	Visible      bool          `json:"visible"`
+}
+
+// CreatePropertyGroup creates the property group and returns it
+func (c *APIClient) CreatePropertyGroup(group *PropertyGroup) (*PropertyGroup, error) {
+	var created PropertyGroup
+	if err := c.createObject(PropertyGroupsAPI, group, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetPropertyGroup returns the property group with the name
+func (c *APIClient) GetPropertyGroup(name string) (*PropertyGroup, error) {
+	var group PropertyGroup
+	if err := c.getObject(fmt.Sprintf(PropertyGroupAPI, name), nil, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// UpdatePropertyGroup replaces the property group with the same name
+func (c *APIClient) UpdatePropertyGroup(group *PropertyGroup) (*PropertyGroup, error) {
+	var updated PropertyGroup
+	if err := c.updateObject(fmt.Sprintf(PropertyGroupAPI, group.Name), group, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeletePropertyGroup deletes the property group with the name
+func (c *APIClient) DeletePropertyGroup(name string) error {
+	return c.deleteObject(fmt.Sprintf(PropertyGroupAPI, name))
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestPropertyGroup(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	name := "WebServers"
+	url := client.BuildEncodedURL(fmt.Sprintf(PropertyGroupAPI, name), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, propertyGroupResponse))
+
+	group, err := client.GetPropertyGroup(name)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "Web servers", group.Label)
+	utils.AssertEqualsInt(t, 2, len(group.Properties))
+	utils.AssertEqualsString(t, "web", group.Properties["Custom.Role"].DefaultValue.String())
+	utils.AssertTrue(t, "encrypted", group.Properties["Custom.Password"].Encrypted)
+	utils.AssertFalse(t, "hidden", group.Properties["Custom.Password"].Visible)
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(PropertyGroupsAPI, nil),
+		httpmock.NewStringResponder(201, propertyGroupResponse))
+	created, err := client.CreatePropertyGroup(&PropertyGroup{Name: name})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, name, created.ID)
+
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(204, ""))
+	updated, err := client.UpdatePropertyGroup(group)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, name, updated.Name)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeletePropertyGroup(name))
+}
@@ -0,0 +1,187 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// request template path error constants
+const (
+	InvalidTemplatePathError   = "The template path %q is not valid: %s"
+	TemplatePathNotFoundError  = "The path %s is not in the template"
+	AmbiguousTemplatePathError = "The key %s matches several paths in the template: %s"
+)
+
+// TemplatePath is the path of a value in the data of a request or action template. Each element is
+// either a map key (string) or a list index (int). For e.g., vSphereVM1.data.cpu or vSphereVM1.data.disks[0].data.capacity
+type TemplatePath []interface{}
+
+// ParseTemplatePath parses a path like vSphereVM1.data.cpu. The keys containing dots are quoted
+// within brackets, for e.g., ["machine2.vsphere"].data.cpu
+func ParseTemplatePath(path string) (TemplatePath, error) {
+	var p TemplatePath
+	for i := 0; i < len(path); {
+		if path[i] == '[' {
+			rest := path[i+1:]
+			if strings.HasPrefix(rest, "\"") {
+				quoted, err := strconv.QuotedPrefix(rest)
+				if err != nil || !strings.HasPrefix(rest[len(quoted):], "]") {
+					return nil, fmt.Errorf(InvalidTemplatePathError, path, "unterminated quoted key")
+				}
+				key, _ := strconv.Unquote(quoted)
+				p = append(p, key)
+				i += len(quoted) + 2
+			} else {
+				end := strings.IndexByte(rest, ']')
+				if end < 0 {
+					return nil, fmt.Errorf(InvalidTemplatePathError, path, "missing ]")
+				}
+				index, err := strconv.Atoi(rest[:end])
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf(InvalidTemplatePathError, path, "the index "+rest[:end]+" is not a number")
+				}
+				p = append(p, index)
+				i += end + 2
+			}
+			if i < len(path) && path[i] != '.' && path[i] != '[' {
+				return nil, fmt.Errorf(InvalidTemplatePathError, path, "missing . after ]")
+			}
+		} else {
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf(InvalidTemplatePathError, path, "empty key")
+			}
+			p = append(p, path[i:i+end])
+			i += end
+		}
+		if i < len(path) && path[i] == '.' {
+			i++
+			if i == len(path) {
+				return nil, fmt.Errorf(InvalidTemplatePathError, path, "empty key")
+			}
+		}
+	}
+	if len(p) == 0 {
+		return nil, fmt.Errorf(InvalidTemplatePathError, path, "empty path")
+	}
+	return p, nil
+}
+
+// Append returns a new path with the elements appended, the path itself is not modified
+func (p TemplatePath) Append(elements ...interface{}) TemplatePath {
+	path := make(TemplatePath, 0, len(p)+len(elements))
+	path = append(path, p...)
+	return append(path, elements...)
+}
+
+// String returns the path in the format read by ParseTemplatePath
+func (p TemplatePath) String() string {
+	var b strings.Builder
+	for i, element := range p {
+		switch e := element.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", e)
+		case string:
+			if e == "" || strings.ContainsAny(e, ".[]\"") {
+				fmt.Fprintf(&b, "[%s]", strconv.Quote(e))
+			} else {
+				if i > 0 {
+					b.WriteString(".")
+				}
+				b.WriteString(e)
+			}
+		}
+	}
+	return b.String()
+}
+
+// TemplateData is the data of a request or action template, addressed by TemplatePath
+type TemplateData map[string]interface{}
+
+// Get returns the value at the path
+func (t TemplateData) Get(path TemplatePath) (interface{}, error) {
+	var value interface{} = map[string]interface{}(t)
+	for i, element := range path {
+		found := false
+		switch e := element.(type) {
+		case string:
+			if m, ok := value.(map[string]interface{}); ok {
+				value, found = m[e]
+			}
+		case int:
+			if l, ok := value.([]interface{}); ok && e < len(l) {
+				value, found = l[e], true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf(TemplatePathNotFoundError, path[:i+1])
+		}
+	}
+	return value, nil
+}
+
+// Set sets the value at the path. The parent of the value has to be in the template, a key that is not
+// in the parent map is added to it
+func (t TemplateData) Set(path TemplatePath, value interface{}) error {
+	if len(path) == 0 {
+		return fmt.Errorf(TemplatePathNotFoundError, path)
+	}
+	parent, err := t.Get(path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	switch e := path[len(path)-1].(type) {
+	case string:
+		if m, ok := parent.(map[string]interface{}); ok {
+			m[e] = value
+			return nil
+		}
+	case int:
+		if l, ok := parent.([]interface{}); ok && e < len(l) {
+			l[e] = value
+			return nil
+		}
+	}
+	return fmt.Errorf(TemplatePathNotFoundError, path)
+}
+
+// Resolve returns the path of the key under root. The key is the name of a property of root, for e.g.,
+// cpu, or an explicit path into a map or a list of root, for e.g., disks[0].data.capacity. A key whose first
+// element is not a map or a list of root is a property name, so that the namespaced custom properties, for
+// e.g., VirtualMachine.Disk0.Size, are properties of root. The maps nested in root are only reached by an
+// explicit path. It returns an error if root has both the property and the path, or if the parent of the
+// path is not in the template, and a nil path if root does not have the property.
+func (t TemplateData) Resolve(root TemplatePath, key string) (TemplatePath, error) {
+	value, err := t.Get(root)
+	if err != nil {
+		return nil, err
+	}
+	m, _ := value.(map[string]interface{})
+	property := root.Append(key)
+	if _, ok := m[key]; !ok {
+		property = nil
+	}
+	var path TemplatePath
+	if elements, err := ParseTemplatePath(key); err == nil && len(elements) > 1 {
+		if first, ok := elements[0].(string); ok {
+			switch m[first].(type) {
+			case map[string]interface{}, []interface{}:
+				path = root.Append(elements...)
+			}
+		}
+	}
+	switch {
+	case path == nil:
+		return property, nil
+	case property != nil:
+		return nil, fmt.Errorf(AmbiguousTemplatePathError, key, property.String()+", "+path.String())
+	}
+	if _, err := t.Get(path[:len(path)-1]); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
@@ -0,0 +1,87 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestParseTemplatePath(t *testing.T) {
+	path, err := ParseTemplatePath("vSphereVM1.data.cpu")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 3, len(path))
+	utils.AssertEqualsString(t, "vSphereVM1.data.cpu", path.String())
+
+	path, err = ParseTemplatePath(`["machine2.vsphere"].data.disks[0].data.capacity`)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 6, len(path))
+	utils.AssertEqualsString(t, "machine2.vsphere", path[0].(string))
+	utils.AssertEqualsInt(t, 0, path[3].(int))
+	utils.AssertEqualsString(t, `["machine2.vsphere"].data.disks[0].data.capacity`, path.String())
+
+	for _, invalidPath := range []string{"", "vSphereVM1..cpu", "vSphereVM1.", "disks[a]", "disks[0", `["vSphereVM1`, "disks[0]data"} {
+		_, err = ParseTemplatePath(invalidPath)
+		utils.AssertNotNilError(t, err)
+	}
+}
+
+func TestTemplateData(t *testing.T) {
+	var requestTemplate CatalogItemRequestTemplate
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(requestTemplateResponse), &requestTemplate))
+	data := TemplateData(requestTemplate.Data)
+
+	path, _ := ParseTemplatePath(`["machine2.vsphere"].data.disks[0].data.capacity`)
+	value, err := data.Get(path)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 8, int(value.(float64)))
+	utils.AssertNilError(t, data.Set(path, 16))
+	value, _ = data.Get(path)
+	utils.AssertEqualsInt(t, 16, value.(int))
+
+	// a missing parent is an error, a missing key is added to its parent
+	missing, _ := ParseTemplatePath(`["machine2.vsphere"].data.disks[1].data.capacity`)
+	err = data.Set(missing, 16)
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(TemplatePathNotFoundError, `["machine2.vsphere"].data.disks[1]`), err.Error())
+	component := TemplatePath{"machine2.vsphere", "data"}
+	utils.AssertNilError(t, data.Set(component.Append("custom_property"), "value"))
+
+	// cpu of the component data, a nested map is only reached by an explicit path
+	path, err = data.Resolve(component, "cpu")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, `["machine2.vsphere"].data.cpu`, path.String())
+	path, err = data.Resolve(TemplatePath{"machine2.vsphere"}, "cpu")
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "cpu of a nested map", path == nil)
+	path, err = data.Resolve(TemplatePath{"machine2.vsphere"}, "data.cpu")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, `["machine2.vsphere"].data.cpu`, path.String())
+	path, err = data.Resolve(component, "disks[0].data.capacity")
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, `["machine2.vsphere"].data.disks[0].data.capacity`, path.String())
+	path, err = data.Resolve(component, "unknown")
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "path of unknown property", path == nil)
+	_, err = data.Resolve(TemplatePath{"machine3.vsphere"}, "cpu")
+	utils.AssertNotNilError(t, err)
+
+	// namespaced custom properties are properties of the component
+	path, err = data.Resolve(component, "VirtualMachine.Disk0.Size")
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "path of unknown custom property", path == nil)
+
+	// a path whose parent is missing, and a key that is both a property and a path
+	_, err = data.Resolve(component, "disks[1].data.capacity")
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(TemplatePathNotFoundError, `["machine2.vsphere"].data.disks[1]`), err.Error())
+	utils.AssertNilError(t, data.Set(component.Append("disks[0].data.capacity"), 8))
+	_, err = data.Resolve(component, "disks[0].data.capacity")
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(AmbiguousTemplatePathError, "disks[0].data.capacity",
+		`["machine2.vsphere"].data["disks[0].data.capacity"], ["machine2.vsphere"].data.disks[0].data.capacity`), err.Error())
+
+	// Append does not modify the path
+	component.Append("nested")
+	utils.AssertEqualsInt(t, 2, len(component))
+}
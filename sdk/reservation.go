@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"fmt"
+)
+
+// reservation API constants
+const (
+	ReservationServiceAPI = "/reservation-service/api"
+	ReservationsAPI       = ReservationServiceAPI + "/reservations"
+	ReservationAPI        = ReservationsAPI + "/%s"
+
+	// type of a vSphere reservation
+	ReservationTypeVSphere = "Infrastructure.Reservation.Virtual.vSphere"
+
+	// keys of the extension data of a reservation
+	ComputeResourceKey      = "computeResource"
+	MachineQuotaKey         = "machineQuota"
+	ReservationMemoryKey    = "reservationMemory"
+	MemoryReservedSizeKey   = "memoryReservedSizeMb"
+	ReservationStoragesKey  = "reservationStorages"
+	StoragePathKey          = "storagePath"
+	StorageReservedSizeKey  = "storageReservedSizeGB"
+	StoragePriorityKey      = "storageReservationPriority"
+	StorageEnabledKey       = "storageEnabled"
+	ReservationNetworksKey  = "reservationNetworks"
+	NetworkPathKey          = "networkPath"
+	NetworkProfileKey       = "networkProfile"
+	ReservationMemoryClass  = "Infrastructure.Reservation.Memory"
+	ReservationStorageClass = "Infrastructure.Reservation.Storage"
+	ReservationNetworkClass = "Infrastructure.Reservation.Network"
+	ComputeResourceClass    = "ComputeResource"
+	StorageClass            = "Storage"
+	NetworkClass            = "Network"
+	NetworkProfileClass     = "NetworkProfile"
+
+	// ids of the alerts of a reservation
+	StorageAlert = "storage"
+	MemoryAlert  = "memory"
+	CPUAlert     = "cpu"
+	MachineAlert = "machine"
+)
+
+// Reservation - reservation of the capacity of a compute resource for a business group
+type Reservation struct {
+	ID                  string                  `json:"id,omitempty"`
+	Name                string                  `json:"name"`
+	ReservationTypeID   string                  `json:"reservationTypeId"`
+	TenantID            string                  `json:"tenantId"`
+	SubTenantID         string                  `json:"subTenantId"`
+	Enabled             bool                    `json:"enabled"`
+	Priority            int                     `json:"priority"`
+	ReservationPolicyID string                  `json:"reservationPolicyId,omitempty"`
+	AlertPolicy         *ReservationAlertPolicy `json:"alertPolicy,omitempty"`
+	ExtensionData       ExtensionData           `json:"extensionData"`
+	Version             int                     `json:"version,omitempty"`
+}
+
+// ReservationAlertPolicy - alerts sent when the usage of a reservation reaches a level
+type ReservationAlertPolicy struct {
+	Enabled           bool               `json:"enabled"`
+	FrequencyReminder int                `json:"frequencyReminder"`
+	EmailBgMgr        bool               `json:"emailBgMgr"`
+	Recipients        []string           `json:"recipients"`
+	Alerts            []ReservationAlert `json:"alerts"`
+}
+
+// ReservationAlert - usage level, in percent, of a resource of a reservation that sends an alert
+type ReservationAlert struct {
+	ID                  string `json:"id"`
+	ReferenceResourceID string `json:"referenceResourceId"`
+	AlertPercentLevel   int    `json:"alertPercentLevel"`
+}
+
+// CreateReservation creates the reservation and returns it with its id
+func (c *APIClient) CreateReservation(reservation *Reservation) (*Reservation, error) {
+	var created Reservation
+	if err := c.createObject(ReservationsAPI, reservation, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetReservation returns the reservation with the id
+func (c *APIClient) GetReservation(id string) (*Reservation, error) {
+	var reservation Reservation
+	if err := c.getObject(fmt.Sprintf(ReservationAPI, id), nil, &reservation); err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// UpdateReservation replaces the reservation with the same id
+func (c *APIClient) UpdateReservation(reservation *Reservation) (*Reservation, error) {
+	var updated Reservation
+	if err := c.updateObject(fmt.Sprintf(ReservationAPI, reservation.ID), reservation, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteReservation deletes the reservation with the id
+func (c *APIClient) DeleteReservation(id string) error {
+	return c.deleteObject(fmt.Sprintf(ReservationAPI, id))
+}
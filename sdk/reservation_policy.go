@@ -0,0 +1,74 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// reservation policy API constants
+const (
+	ReservationPoliciesAPI = ReservationsAPI + "/policies"
+	ReservationPolicyAPI   = ReservationPoliciesAPI + "/%s"
+
+	// types of the reservation policies
+	ReservationPolicyTypeCompute = "Infrastructure.Reservation.Policy.ComputeResource"
+	ReservationPolicyTypeStorage = "Infrastructure.Reservation.Policy.Storage"
+)
+
+// ReservationPolicy - policy that restricts the placement of machines to reservations, or of disks to storage paths
+type ReservationPolicy struct {
+	ID                      string `json:"id,omitempty"`
+	Name                    string `json:"name"`
+	Description             string `json:"description,omitempty"`
+	ReservationPolicyTypeID string `json:"reservationPolicyTypeId"`
+	TenantID                string `json:"tenantId,omitempty"`
+}
+
+// CreateReservationPolicy creates the reservation policy and returns it with its id
+func (c *APIClient) CreateReservationPolicy(policy *ReservationPolicy) (*ReservationPolicy, error) {
+	var created ReservationPolicy
+	if err := c.createObject(ReservationPoliciesAPI, policy, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetReservationPolicy returns the reservation policy with the id
+func (c *APIClient) GetReservationPolicy(id string) (*ReservationPolicy, error) {
+	var policy ReservationPolicy
+	if err := c.getObject(fmt.Sprintf(ReservationPolicyAPI, id), nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// UpdateReservationPolicy replaces the reservation policy with the same id
+func (c *APIClient) UpdateReservationPolicy(policy *ReservationPolicy) (*ReservationPolicy, error) {
+	var updated ReservationPolicy
+	if err := c.updateObject(fmt.Sprintf(ReservationPolicyAPI, policy.ID), policy, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteReservationPolicy deletes the reservation policy with the id
+func (c *APIClient) DeleteReservationPolicy(id string) error {
+	return c.deleteObject(fmt.Sprintf(ReservationPolicyAPI, id))
+}
+
+// FindReservationPolicies returns the reservation policies of the type with the name
+func (c *APIClient) FindReservationPolicies(name, policyType string) ([]ReservationPolicy, error) {
+	var policies []ReservationPolicy
+	err := c.listObjects(ReservationPoliciesAPI, map[string]string{
+		"$filter": fmt.Sprintf("name eq '%s'", strings.Replace(name, "'", "''", -1))}, &policies)
+	if err != nil {
+		return nil, err
+	}
+	matching := policies[:0]
+	for _, policy := range policies {
+		if policy.Name == name && policy.ReservationPolicyTypeID == policyType {
+			matching = append(matching, policy)
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestReservationPolicy(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "d1e2f3a4-0000-4000-8000-000000000003"
+	url := client.BuildEncodedURL(fmt.Sprintf(ReservationPolicyAPI, id), nil)
+	policyResponse := `{"id":"` + id + `","name":"Gold","reservationPolicyTypeId":"` + ReservationPolicyTypeCompute + `"}`
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, policyResponse))
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(ReservationPoliciesAPI, nil),
+		httpmock.NewStringResponder(201, policyResponse))
+	httpmock.RegisterResponder("PUT", url,
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+
+	created, err := client.CreateReservationPolicy(&ReservationPolicy{Name: "Gold", ReservationPolicyTypeID: ReservationPolicyTypeCompute})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, created.ID)
+	policy, err := client.GetReservationPolicy(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "Gold", policy.Name)
+	updated, err := client.UpdateReservationPolicy(policy)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, updated.ID)
+	utils.AssertNilError(t, client.DeleteReservationPolicy(id))
+}
+
+func TestFindReservationPolicies(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	httpmock.RegisterResponder("GET", client.BuildEncodedURL(ReservationPoliciesAPI, map[string]string{
+		"$filter": "name eq 'Gold'",
+		"page":    "1"}), httpmock.NewStringResponder(200, reservationPoliciesResponse))
+
+	// the compute and the storage policies can have the same name
+	policies, err := client.FindReservationPolicies("Gold", ReservationPolicyTypeStorage)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(policies))
+	utils.AssertEqualsString(t, "e2f3a4b5-0000-4000-8000-000000000004", policies[0].ID)
+}
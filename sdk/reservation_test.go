@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestExtensionData(t *testing.T) {
+	var reservation Reservation
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(reservationResponse), &reservation))
+
+	extensionData := &reservation.ExtensionData
+	utils.AssertEqualsString(t, "cr-1", extensionData.Get(ComputeResourceKey).EntityID())
+	utils.AssertEqualsInt(t, 10, extensionData.Get(MachineQuotaKey).Int())
+	utils.AssertEqualsInt(t, 8192, extensionData.Get(ReservationMemoryKey).Values.Get(MemoryReservedSizeKey).Int())
+	storage := extensionData.Get(ReservationStoragesKey).Items[0]
+	utils.AssertFalse(t, "storage disabled", storage.Values.Get(StorageEnabledKey).Bool())
+	utils.AssertTrue(t, "unknown key", extensionData.Get("unknown") == nil)
+	utils.AssertEqualsInt(t, 0, extensionData.Get("unknown").Int())
+
+	extensionData.Set(MachineQuotaKey, NewIntegerValue(0))
+	extensionData.Set("reservationPriority", NewBooleanValue(false))
+	utils.AssertEqualsInt(t, 7, len(extensionData.Entries))
+	extensionData.Remove("reservationPriority")
+	utils.AssertEqualsInt(t, 6, len(extensionData.Entries))
+
+	// the zero values are kept in the JSON of the reservation
+	buffer, err := utils.MarshalToJSON(reservation)
+	utils.AssertNilError(t, err)
+	data := buffer.String()
+	utils.AssertContainsString(t, `{"key":"machineQuota","value":{"type":"integer","value":0}}`, data)
+	utils.AssertContainsString(t, `{"key":"storageEnabled","value":{"type":"boolean","value":false}}`, data)
+}
+
+func TestReservation(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "5e1a6c2f-8d3b-4a7e-9f10-2b3c4d5e6f70"
+	url := client.BuildEncodedURL(fmt.Sprintf(ReservationAPI, id), nil)
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, reservationResponse))
+
+	reservation, err := client.GetReservation(id)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "Development-vSphere", reservation.Name)
+	utils.AssertEqualsInt(t, 4, reservation.Version)
+	utils.AssertEqualsInt(t, 90, reservation.AlertPolicy.Alerts[0].AlertPercentLevel)
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(ReservationsAPI, nil),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(201, "")
+			resp.Header.Set("Location", url)
+			return resp, nil
+		})
+	created, err := client.CreateReservation(&Reservation{Name: "Development-vSphere"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, created.ID)
+
+	httpmock.RegisterResponder("PUT", url,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			if !strings.Contains(string(data), `"version":4`) {
+				return httpmock.NewStringResponse(409, ""), nil
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	updated, err := client.UpdateReservation(reservation)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, id, updated.ID)
+
+	httpmock.RegisterResponder("DELETE", url,
+		httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, client.DeleteReservation(id))
+}
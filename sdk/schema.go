@@ -6,26 +6,73 @@ import (
 
 // ResourceConfigurationStruct - structure representing the resource_configuration
 type ResourceConfigurationStruct struct {
-	ComponentName    string                 `json:"component_name,omitempty"`
-	Cluster          int                    `json:"cluster,omitempty"`
-	Description      string                 `json:"description,omitempty"`
-	RequestID        string                 `json:"request_id,omitempty"`
-	Instances        []Instance             `json:"instances,omitempty"`
-	Configuration    map[string]interface{} `json:"configuration,omitempty"`
-	ParentResourceID string                 `json:"parent_resource_id,omitempty"`
+	ComponentName    string                        `json:"component_name,omitempty"`
+	Cluster          int                           `json:"cluster,omitempty"`
+	Description      string                        `json:"description,omitempty"`
+	RequestID        string                        `json:"request_id,omitempty"`
+	Instances        []Instance                    `json:"instances,omitempty"`
+	Configuration    map[string]interface{}        `json:"configuration,omitempty"`
+	ParentResourceID string                        `json:"parent_resource_id,omitempty"`
+	Disks            []DiskConfiguration           `json:"disk,omitempty"`
+	NetworkAdapters  []NetworkAdapterConfiguration `json:"network_adapter,omitempty"`
+}
+
+// DiskConfiguration - structure representing a disk block of the resource_configuration
+type DiskConfiguration struct {
+	Size       int    `json:"size,omitempty"`
+	Label      string `json:"label,omitempty"`
+	MountPoint string `json:"mount_point,omitempty"`
+}
+
+// NetworkAdapterConfiguration - structure representing a network_adapter block of the resource_configuration
+type NetworkAdapterConfiguration struct {
+	NetworkName    string `json:"network_name,omitempty"`
+	NetworkProfile string `json:"network_profile,omitempty"`
+	Address        string `json:"address,omitempty"`
+	Order          int    `json:"order,omitempty"`
 }
 
 // Instance - structure representing an instance(VM)
 type Instance struct {
-	ResourceID   string                 `json:"resource_id,omitempty"`
-	Description  string                 `json:"description,omitempty"`
-	Status       string                 `json:"status,omitempty"`
-	Properties   map[string]interface{} `json:"properties,omitempty"`
-	DateCreated  string                 `json:"date_created,omitempty"`
-	LastUpdated  string                 `json:"last_updated,omitempty"`
-	Name         string                 `json:"name,omitempty"`
-	ResourceType string                 `json:"resource_type,omitempty"`
-	IPAddress    string                 `json:"ip_address,omitempty"`
+	ResourceID     string                 `json:"resource_id,omitempty"`
+	Description    string                 `json:"description,omitempty"`
+	Status         string                 `json:"status,omitempty"`
+	Properties     map[string]interface{} `json:"properties,omitempty"`
+	DateCreated    string                 `json:"date_created,omitempty"`
+	LastUpdated    string                 `json:"last_updated,omitempty"`
+	Name           string                 `json:"name,omitempty"`
+	ResourceType   string                 `json:"resource_type,omitempty"`
+	IPAddress      string                 `json:"ip_address,omitempty"`
+	ConnectionHost string                 `json:"connection_host,omitempty"`
+	Disks          []Disk                 `json:"disks,omitempty"`
+	Networks       []Network              `json:"networks,omitempty"`
+}
+
+// Disk - structure representing a disk volume attached to an instance(VM)
+type Disk struct {
+	Capacity                 int    `json:"capacity,omitempty"`
+	Label                    string `json:"label,omitempty"`
+	Datastore                string `json:"datastore,omitempty"`
+	StorageReservationPolicy string `json:"storage_reservation_policy,omitempty"`
+}
+
+// Network - structure representing a network adapter of an instance(VM)
+type Network struct {
+	NetworkName    string `json:"network_name,omitempty"`
+	NetworkProfile string `json:"network_profile,omitempty"`
+	IPv4Address    string `json:"ipv4_address,omitempty"`
+	IPv6Address    string `json:"ipv6_address,omitempty"`
+	MACAddress     string `json:"mac_address,omitempty"`
+	Gateway        string `json:"gateway,omitempty"`
+}
+
+// XaaSResource - structure representing a custom resource provisioned by an XaaS blueprint
+type XaaSResource struct {
+	ResourceID    string            `json:"resource_id,omitempty"`
+	Name          string            `json:"name,omitempty"`
+	ResourceType  string            `json:"resource_type,omitempty"`
+	ComponentName string            `json:"component_name,omitempty"`
+	Outputs       map[string]string `json:"outputs,omitempty"`
 }
 
 // RequestResponse is the response structure of any request
@@ -66,6 +113,12 @@ type BusinessGroup struct {
 	ID   string `json:"id,omitempty"`
 }
 
+// CatalogRequests - a page of catalog requests
+type CatalogRequests struct {
+	Content  []CatalogRequest `json:"content,omitempty"`
+	MetaData Metadata         `json:"metadata,omitempty"`
+}
+
 // RequestResourceView - resource view of a provisioned request
 type RequestResourceView struct {
 	Content  []interface{} `json:"content,omitempty"`
@@ -76,8 +129,9 @@ type RequestResourceView struct {
 // Resources - Retrieves the resources that were provisioned as a result of a given request.
 // Also returns the actions allowed on the resources and their templates
 type Resources struct {
-	Links   []interface{}     `json:"links,omitempty"`
-	Content []ResourceContent `json:"content,omitempty"`
+	Links    []interface{}     `json:"links,omitempty"`
+	Content  []ResourceContent `json:"content,omitempty"`
+	MetaData Metadata          `json:"metadata,omitempty"`
 }
 
 // ResourceContent - Detailed view of the resource provisioned and the operation allowed
@@ -90,6 +144,14 @@ type ResourceContent struct {
 	RequestState    string          `json:"requestState,omitempty"`
 	Operations      []Operation     `json:"operations,omitempty"`
 	ResourceData    ResourceDataMap `json:"resourceData,omitempty"`
+	CatalogItem     struct {
+		ID    string `json:"id,omitempty"`
+		Label string `json:"label,omitempty"`
+	} `json:"catalogItem,omitempty"`
+	Organization struct {
+		SubtenantRef   string `json:"subtenantRef,omitempty"`
+		SubtenantLabel string `json:"subtenantLabel,omitempty"`
+	} `json:"organization,omitempty"`
 }
 
 // ResourceTypeRef - type of resource (deployment, or machine, etc)
@@ -180,6 +242,64 @@ type CatalogItemRequestTemplate struct {
 	Data            map[string]interface{} `json:"data,omitempty"`
 }
 
+// CatalogItemRequestSchema - The form schema of a catalog item request. The fields of the components
+// carry their own schema.
+type CatalogItemRequestSchema struct {
+	Fields []RequestSchemaField `json:"fields,omitempty"`
+}
+
+// RequestSchemaField - A field of a request schema and its constraints
+type RequestSchemaField struct {
+	ID       string `json:"id,omitempty"`
+	Label    string `json:"label,omitempty"`
+	DataType struct {
+		Type   string                    `json:"type,omitempty"`
+		TypeID string                    `json:"typeId,omitempty"`
+		Schema *CatalogItemRequestSchema `json:"schema,omitempty"`
+	} `json:"dataType,omitempty"`
+	State struct {
+		Facets []RequestSchemaFacet `json:"facets,omitempty"`
+	} `json:"state,omitempty"`
+}
+
+// RequestSchemaFacet - A constraint on the value of a field, for e.g., minValue
+type RequestSchemaFacet struct {
+	Type  string `json:"type,omitempty"`
+	Value struct {
+		Type  string `json:"type,omitempty"`
+		Value struct {
+			Type  string      `json:"type,omitempty"`
+			Value interface{} `json:"value,omitempty"`
+		} `json:"value,omitempty"`
+	} `json:"value,omitempty"`
+}
+
+// GetField returns the field of the schema with the given id
+func (s *CatalogItemRequestSchema) GetField(id string) *RequestSchemaField {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Fields {
+		if s.Fields[i].ID == id {
+			return &s.Fields[i]
+		}
+	}
+	return nil
+}
+
+// GetFacetValue returns the value of the facet of the given type, and false if the field has no such facet
+func (f *RequestSchemaField) GetFacetValue(facetType string) (interface{}, bool) {
+	if f == nil {
+		return nil, false
+	}
+	for _, facet := range f.State.Facets {
+		if facet.Type == facetType && facet.Value.Value.Value != nil {
+			return facet.Value.Value.Value, true
+		}
+	}
+	return nil, false
+}
+
 // catalogName - This struct holds catalog name from json response.
 type catalogName struct {
 	Name string `json:"name"`
@@ -28,6 +28,7 @@ const (
 	GetActionTemplateAPI           = PostActionTemplateAPI + "/template"
 	GetRequestResourceViewAPI      = ConsumerRequests + "/" + "%s" + "/resourceViews"
 	RequestTemplateAPI             = EntitledCatalogItems + "/" + "%s" + "/requests/template"
+	RequestSchemaAPI               = EntitledCatalogItems + "/" + "%s" + "/requests/schema"
 	GetDeploymentAPI               = Consumer + "/deployments/%s"
 	AuthenticationIdentityTokenAPI = "%s" + Tokens
 
@@ -35,6 +36,7 @@ const (
 	Successful             = "SUCCESSFUL"
 	Failed                 = "FAILED"
 	Submitted              = "SUBMITTED"
+	Rejected               = "REJECTED"
 	InfrastructureVirtual  = "Infrastructure.Virtual"
 	DeploymentResourceType = "composition.resource.type.deployment"
 	Component              = "Component"
@@ -43,6 +45,53 @@ const (
 	ScaleOut               = "Scale Out"
 	ScaleIn                = "Scale In"
 	DeploymentDestroy      = "Deployment Destroy"
+	ChangeLease            = "Change Lease"
+	ProviderExpirationDate = "provider-ExpirationDate"
+
+	// status of a catalog resource
+	ResourceStatusActive   = "ACTIVE"
+	ResourceStatusExpired  = "EXPIRED"
+	ResourceStatusArchived = "ARCHIVED"
+
+	// constraints of the fields of a request schema
+	LeaseDays     = "_leaseDays"
+	Cluster       = "_cluster"
+	MinValueFacet = "minValue"
+	MaxValueFacet = "maxValue"
+	// type of the secured fields of a request schema
+	SecureStringType = "SECURE_STRING"
+
+	// keys of the machine resource data
+	DiskVolumes       = "DISK_VOLUMES"
+	DiskCapacity      = "DISK_CAPACITY"
+	DiskLabel         = "DISK_LABEL"
+	DiskStorage       = "DISK_STORAGE"
+	NetworkList       = "NETWORK_LIST"
+	NetworkName       = "NETWORK_NAME"
+	NetworkAddress    = "NETWORK_ADDRESS"
+	NetworkMACAddress = "NETWORK_MAC_ADDRESS"
+	NetworkProfile    = "NETWORK_PROFILE"
+	IPAddress         = "ip_address"
+	MachineName       = "MachineName"
+	StorageName       = "VirtualMachine.Storage.Name"
+	DiskProperty      = "VirtualMachine.Disk%d.%s"
+	NetworkProperty   = "VirtualMachine.Network%d.%s"
+
+	// keys of the reconfigure action template
+	IaaSProxyProvider  = "com.vmware.csp.component.iaas.proxy.provider"
+	MachineDiskClassID = "Infrastructure.Compute.Machine.MachineDisk"
+	MachineNicClassID  = "Infrastructure.Compute.Machine.Nic"
+	ReconfigureDisks   = "disks"
+	ReconfigureNics    = "nics"
+
+	ReconfigureExecutionSelector   = "executionSelector"
+	ReconfigureExecutionImmediate  = "1"
+	ReconfigureExecutionScheduled  = "2"
+	ReconfigureScheduleDate        = "scheduleDate"
+	ReconfigurePowerActionSelector = "powerActionSelector"
+	ReconfigurePowerActionReboot   = "0"
+	ReconfigurePowerActionNoReboot = "1"
+	ReconfigureAllowForceShutdown  = "allowForceShutdown"
 )
 
 // GetCatalogItemRequestTemplate - Call to retrieve a request template for a catalog item.
@@ -64,6 +113,23 @@ func (c *APIClient) GetCatalogItemRequestTemplate(catalogItemID string) (*Catalo
 	return &requestTemplate, nil
 }
 
+// GetCatalogItemRequestSchema - Call to retrieve the request schema of a catalog item, with the constraints of its fields.
+func (c *APIClient) GetCatalogItemRequestSchema(catalogItemID string) (*CatalogItemRequestSchema, error) {
+	path := fmt.Sprintf(RequestSchemaAPI, catalogItemID)
+	url := c.BuildEncodedURL(path, nil)
+	resp, respErr := c.Get(url, nil)
+	if respErr != nil {
+		return nil, respErr
+	}
+
+	var requestSchema CatalogItemRequestSchema
+	unmarshallErr := utils.UnmarshalJSON(resp.Body, &requestSchema)
+	if unmarshallErr != nil {
+		return nil, unmarshallErr
+	}
+	return &requestSchema, nil
+}
+
 // ReadCatalogItemNameByID - This function returns the catalog item name using catalog item ID
 func (c *APIClient) ReadCatalogItemNameByID(catalogItemID string) (string, error) {
 
@@ -233,6 +299,25 @@ func (c *APIClient) RequestCatalogItem(requestTemplate *CatalogItemRequestTempla
 	return &response, nil
 }
 
+// FindCatalogRequestsByDescription returns the catalog requests whose description contains the text,
+// the most recently submitted first
+func (c *APIClient) FindCatalogRequestsByDescription(text string) ([]CatalogRequest, error) {
+	url := c.BuildEncodedURL(ConsumerRequests, map[string]string{
+		"$filter":  fmt.Sprintf("substringof('%s',description)", strings.Replace(text, "'", "''", -1)),
+		"$orderby": "dateSubmitted desc"})
+	resp, respErr := c.Get(url, nil)
+	if respErr != nil {
+		return nil, respErr
+	}
+
+	var requests CatalogRequests
+	unmarshallErr := utils.UnmarshalJSON(resp.Body, &requests)
+	if unmarshallErr != nil {
+		return nil, unmarshallErr
+	}
+	return requests.Content, nil
+}
+
 // GetRequestResources get the resource actions allowed for a resource
 func (c *APIClient) GetRequestResources(catalogItemRequestID string) (*Resources, error) {
 	path := fmt.Sprintf(GetRequestResourcesAPI, catalogItemRequestID)
@@ -251,6 +336,17 @@ func (c *APIClient) GetRequestResources(catalogItemRequestID string) (*Resources
 	return &requestResources, nil
 }
 
+// FindResourcesByName returns the catalog resources of the resource type with the given name
+func (c *APIClient) FindResourcesByName(name, resourceType string) ([]ResourceContent, error) {
+	var resources []ResourceContent
+	err := c.listObjects(ConsumerResources, map[string]string{
+		"$filter": fmt.Sprintf("name eq '%s' and resourceType/id eq '%s'", strings.Replace(name, "'", "''", -1), resourceType)}, &resources)
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
 // GetResource get the resource actions allowed for a resource
 func (c *APIClient) GetResource(resourceID string) (*ResourceContent, error) {
 	path := fmt.Sprintf(GetResourceAPI, resourceID)
@@ -59,6 +59,41 @@ func TestGetCatalogItemRequestTemplate(t *testing.T) {
 
 }
 
+func TestGetCatalogItemRequestSchema(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	catalogItemID := "feaedf73-560c-4612-a573-41667e017691"
+
+	path := fmt.Sprintf(RequestSchemaAPI, catalogItemID)
+	url := client.BuildEncodedURL(path, nil)
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, requestSchemaResponse))
+
+	requestSchema, err := client.GetCatalogItemRequestSchema(catalogItemID)
+	utils.AssertNilError(t, err)
+	utils.AssertNotNil(t, requestSchema)
+	min, ok := requestSchema.GetField(LeaseDays).GetFacetValue(MinValueFacet)
+	utils.AssertTrue(t, "lease days has a minimum", ok)
+	utils.AssertEqualsInt(t, 1, int(min.(float64)))
+	max, ok := requestSchema.GetField("machine2.vsphere").DataType.Schema.GetField(Cluster).GetFacetValue(MaxValueFacet)
+	utils.AssertTrue(t, "cluster has a maximum", ok)
+	utils.AssertEqualsInt(t, 3, int(max.(float64)))
+	_, ok = requestSchema.GetField("machine1.vsphere").GetFacetValue(MaxValueFacet)
+	utils.AssertFalse(t, "unknown component has a maximum", ok)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(20116, requestTemplateErrorResponse))
+	requestSchema, err = client.GetCatalogItemRequestSchema(catalogItemID)
+	utils.AssertNotNilError(t, err)
+	utils.AssertNil(t, requestSchema)
+}
+
 func TestReadCatalogItemNameByID(t *testing.T) {
 
 	httpmock.ActivateNonDefault(client.Client)
@@ -277,3 +312,62 @@ func TestGetDeployment(t *testing.T) {
 	utils.AssertNotNilError(t, err)
 	utils.AssertNil(t, deployment)
 }
+
+func TestFindCatalogRequestsByDescription(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	marker := "tf-4b0d2f6c8a1e9d3b7f52"
+	url := client.BuildEncodedURL(ConsumerRequests, map[string]string{
+		"$filter":  "substringof('" + marker + "',description)",
+		"$orderby": "dateSubmitted desc"})
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, catalogRequestsResponse))
+
+	requests, err := client.FindCatalogRequestsByDescription(marker)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(requests))
+	utils.AssertEqualsString(t, "7aaf9baf-aa4e-47c4-997b-edd7c7983a5b", requests[0].ID)
+	utils.AssertEqualsString(t, Successful, requests[0].Phase)
+	utils.AssertContainsString(t, marker, requests[0].Description)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(500, systemExceptionResponse))
+	requests, err = client.FindCatalogRequestsByDescription(marker)
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsInt(t, 0, len(requests))
+}
+
+func TestFindResourcesByName(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", authenticationAPI,
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	url := client.BuildEncodedURL(ConsumerResources, map[string]string{
+		"$filter": "name eq 'web-servers' and resourceType/id eq '" + DeploymentResourceType + "'",
+		"page":    "1"})
+
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(200, deploymentResourcesResponse))
+
+	resources, err := client.FindResourcesByName("web-servers", DeploymentResourceType)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(resources))
+	utils.AssertEqualsString(t, "0dd9d9d9-a4a0-4c8c-8e5e-8bd1c3f5e6a7", resources[0].ID)
+	utils.AssertEqualsString(t, "7aaf9baf-aa4e-47c4-997b-edd7c7983a5b", resources[0].RequestID)
+	utils.AssertEqualsString(t, "feaedf73-560c-4612-a573-41667e017691", resources[0].CatalogItem.ID)
+	utils.AssertEqualsString(t, "6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a", resources[0].Organization.SubtenantRef)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", url,
+		httpmock.NewStringResponder(500, systemExceptionResponse))
+	_, err = client.FindResourcesByName("web-servers", DeploymentResourceType)
+	utils.AssertNotNilError(t, err)
+}
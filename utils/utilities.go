@@ -43,10 +43,10 @@ func ConvertInterfaceToString(interfaceData interface{}) string {
 	var stringData string
 	if reflect.ValueOf(interfaceData).Kind() == reflect.Float64 {
 		stringData =
-			strconv.FormatFloat(interfaceData.(float64), 'f', 0, 64)
+			strconv.FormatFloat(reflect.ValueOf(interfaceData).Float(), 'f', -1, 64)
 	} else if reflect.ValueOf(interfaceData).Kind() == reflect.Float32 {
 		stringData =
-			strconv.FormatFloat(interfaceData.(float64), 'f', 0, 32)
+			strconv.FormatFloat(reflect.ValueOf(interfaceData).Float(), 'f', -1, 32)
 	} else if reflect.ValueOf(interfaceData).Kind() == reflect.Int {
 		stringData = strconv.Itoa(interfaceData.(int))
 	} else if reflect.ValueOf(interfaceData).Kind() == reflect.String {
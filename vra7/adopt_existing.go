@@ -0,0 +1,63 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// AmbiguousAdoptionError is returned when several deployments match the adopt_existing block
+const AmbiguousAdoptionError = "Several deployments named %s of the catalog item %s in the business group %s can be adopted: %s"
+
+func adoptExistingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// getAdoptExistingName returns the name of the deployment to adopt, or an empty string without an adopt_existing block
+func getAdoptExistingName(d resourceGetter) string {
+	blocks := d.Get("adopt_existing").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return ""
+	}
+	return strings.TrimSpace(blocks[0].(map[string]interface{})["name"].(string))
+}
+
+// findDeploymentToAdopt returns the deployment with the name that was requested from the catalog item in the
+// business group, or nil if there is none. It returns an error if several deployments match.
+func findDeploymentToAdopt(vraClient *sdk.APIClient, name, catalogItemID, businessGroupID string) (*sdk.ResourceContent, error) {
+	resources, err := vraClient.FindResourcesByName(name, sdk.DeploymentResourceType)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []sdk.ResourceContent
+	for _, resource := range resources {
+		if resource.Name == name && resource.CatalogItem.ID == catalogItemID && resource.Organization.SubtenantRef == businessGroupID {
+			candidates = append(candidates, resource)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &candidates[0], nil
+	}
+	ids := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.ID)
+	}
+	return nil, fmt.Errorf(AmbiguousAdoptionError, name, catalogItemID, businessGroupID, strings.Join(ids, ", "))
+}
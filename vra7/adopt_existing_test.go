@@ -0,0 +1,60 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestGetAdoptExistingName(t *testing.T) {
+	resourceSchema := resourceVra7Deployment().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	utils.AssertEqualsString(t, "", getAdoptExistingName(d))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"adopt_existing": []interface{}{map[string]interface{}{"name": " web-servers "}},
+	})
+	utils.AssertEqualsString(t, "web-servers", getAdoptExistingName(d))
+}
+
+func TestFindDeploymentToAdopt(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	url := client.BuildEncodedURL(sdk.ConsumerResources, map[string]string{
+		"$filter": "name eq 'web-servers' and resourceType/id eq '" + sdk.DeploymentResourceType + "'",
+		"page":    "1"})
+	resources := func(catalogItemIDs ...string) string {
+		content := make([]string, 0, len(catalogItemIDs))
+		for i, catalogItemID := range catalogItemIDs {
+			content = append(content, fmt.Sprintf(`{"id": "deployment-%d", "name": "web-servers", "requestId": "request-%d",
+				"catalogItem": {"id": "%s"}, "organization": {"subtenantRef": "bg-1"}}`, i, i, catalogItemID))
+		}
+		return `{"content": [` + strings.Join(content, ",") + `], "metadata": {"number": 1, "totalPages": 1}}`
+	}
+
+	// only the deployment of the catalog item matches
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, resources("centos", "ubuntu")))
+	deployment, err := findDeploymentToAdopt(&client, "web-servers", "ubuntu", "bg-1")
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "deployment found", deployment != nil)
+	utils.AssertEqualsString(t, "request-1", deployment.RequestID)
+
+	deployment, err = findDeploymentToAdopt(&client, "web-servers", "ubuntu", "bg-2")
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "no deployment in the business group", deployment == nil)
+
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, resources("centos", "centos")))
+	_, err = findDeploymentToAdopt(&client, "web-servers", "centos", "bg-1")
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(AmbiguousAdoptionError, "web-servers", "centos", "bg-1", "deployment-0, deployment-1"), err.Error())
+}
@@ -0,0 +1,169 @@
+package vra7
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// InvalidBlueprintContentError is returned when the content of a composite blueprint is not a YAML or JSON document
+const InvalidBlueprintContentError = "The content of the composite blueprint is not a valid YAML or JSON document: %v"
+
+// blueprintManagedFields are the fields of a blueprint document that vRA manages. They are left out when the
+// content in the configuration is compared to the blueprint; the publish status is set by the publish argument.
+var blueprintManagedFields = []string{"@type", "status", "createdDate", "lastUpdated"}
+
+// parseBlueprintContent parses the YAML or JSON content of a composite blueprint into a document whose
+// values are the ones of a decoded JSON document, without the fields managed by vRA
+func parseBlueprintContent(content string) (sdk.BlueprintDocument, error) {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(content), &parsed); err != nil {
+		if yamlErr := yaml.Unmarshal([]byte(content), &parsed); yamlErr != nil {
+			return nil, fmt.Errorf(InvalidBlueprintContentError, yamlErr)
+		}
+	}
+	normalized, err := normalizeBlueprintValue(parsed)
+	if err != nil {
+		return nil, fmt.Errorf(InvalidBlueprintContentError, err)
+	}
+	document, ok := normalized.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(InvalidBlueprintContentError, "the document is not a map")
+	}
+	return withoutManagedFields(document), nil
+}
+
+// normalizeBlueprintValue converts the maps decoded from YAML to maps with string keys, and the numbers
+// to the float64 values decoded from JSON, so that a YAML and a JSON document compare equal
+func normalizeBlueprintValue(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(stringKeys(value))
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+func stringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = stringKeys(item)
+		}
+		return converted
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[key] = stringKeys(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			converted = append(converted, stringKeys(item))
+		}
+		return converted
+	}
+	return value
+}
+
+func withoutManagedFields(document map[string]interface{}) sdk.BlueprintDocument {
+	stripped := make(sdk.BlueprintDocument, len(document))
+	for key, value := range document {
+		stripped[key] = value
+	}
+	for _, field := range blueprintManagedFields {
+		delete(stripped, field)
+	}
+	return stripped
+}
+
+// pruneBlueprintValue returns the part of the remote value that has the shape of the configured value: the
+// fields that vRA adds to the maps of the configuration are left out, so that only the configured fields are
+// compared. A configured field that is missing in the remote value stays missing.
+func pruneBlueprintValue(remote, configured interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+		pruned := make(map[string]interface{}, len(c))
+		for key, configuredValue := range c {
+			if remoteValue, ok := r[key]; ok {
+				pruned[key] = pruneBlueprintValue(remoteValue, configuredValue)
+			}
+		}
+		return pruned
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok || len(r) != len(c) {
+			return remote
+		}
+		pruned := make([]interface{}, 0, len(r))
+		for i := range r {
+			pruned = append(pruned, pruneBlueprintValue(r[i], c[i]))
+		}
+		return pruned
+	}
+	return remote
+}
+
+// blueprintContentDrift returns the content to set in the state for the blueprint document read from vRA: the
+// configured content if the blueprint still matches it, or else the JSON of the part of the blueprint that
+// differs from it. Without configured content, like after an import, it is the JSON of the whole blueprint.
+func blueprintContentDrift(configuredContent string, document sdk.BlueprintDocument) (string, error) {
+	remote, err := normalizeBlueprintValue(map[string]interface{}(withoutManagedFields(document)))
+	if err != nil {
+		return "", err
+	}
+	if configuredContent != "" {
+		configured, err := parseBlueprintContent(configuredContent)
+		if err == nil {
+			remote = pruneBlueprintValue(remote, map[string]interface{}(configured))
+			if reflect.DeepEqual(remote, map[string]interface{}(configured)) {
+				return configuredContent, nil
+			}
+		}
+	}
+	data, err := json.MarshalIndent(remote, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// suppressEquivalentBlueprintContent suppresses the differences of format between the YAML or JSON contents
+func suppressEquivalentBlueprintContent(k, old, new string, d *schema.ResourceData) bool {
+	oldDocument, err := parseBlueprintContent(old)
+	if err != nil {
+		return false
+	}
+	newDocument, err := parseBlueprintContent(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldDocument, newDocument)
+}
+
+// validateBlueprintContent checks that the content is a blueprint document with an id and a name
+func validateBlueprintContent(v interface{}, k string) (ws []string, errors []error) {
+	document, err := parseBlueprintContent(v.(string))
+	if err != nil {
+		return nil, []error{err}
+	}
+	for _, field := range []string{"id", "name"} {
+		if value, ok := document[field].(string); !ok || value == "" {
+			errors = append(errors, fmt.Errorf("%q: the composite blueprint has no %s", k, field))
+		}
+	}
+	return nil, errors
+}
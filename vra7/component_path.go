@@ -0,0 +1,135 @@
+package vra7
+
+import (
+	"strings"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// ComponentNameSeparator separates the names of nested components in a component_name. The software
+// component Tomcat on the machine Web of the nested blueprint App is App.Web.Tomcat
+const ComponentNameSeparator = "."
+
+// getComponentPath returns the path of the component in the data of a request or action template,
+// App.data.Web.data.Tomcat for App.Web.Tomcat, or nil if the component is not in the template.
+// A component name may itself contain dots, so the whole name is looked up first and, at each level,
+// the longest name of a component is preferred.
+func getComponentPath(templateData map[string]interface{}, componentName string) sdk.TemplatePath {
+	names := strings.Split(componentName, ComponentNameSeparator)
+	var find func(data map[string]interface{}, names []string, path sdk.TemplatePath) sdk.TemplatePath
+	find = func(data map[string]interface{}, names []string, path sdk.TemplatePath) sdk.TemplatePath {
+		for i := len(names); i > 0; i-- {
+			name := strings.Join(names[:i], ComponentNameSeparator)
+			component, ok := data[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if i == len(names) {
+				return path.Append(name)
+			}
+			if nested, ok := component["data"].(map[string]interface{}); ok {
+				if p := find(nested, names[i:], path.Append(name, "data")); p != nil {
+					return p
+				}
+			}
+		}
+		return nil
+	}
+	return find(templateData, names, sdk.TemplatePath{})
+}
+
+// lookupComponentPath returns the path of the component in the data of a request or action template like
+// getComponentPath. A component of a nested blueprint used to be addressed by its own name, such a
+// component_name still matches the component when no other component of the template has that name.
+func lookupComponentPath(templateData map[string]interface{}, componentName string) sdk.TemplatePath {
+	if path := getComponentPath(templateData, componentName); path != nil {
+		return path
+	}
+	var found []sdk.TemplatePath
+	var find func(data map[string]interface{}, path sdk.TemplatePath)
+	find = func(data map[string]interface{}, path sdk.TemplatePath) {
+		for _, name := range sortedKeys(data) {
+			component, ok := data[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			nested, ok := component["data"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name == componentName {
+				found = append(found, path.Append(name))
+			}
+			find(nested, path.Append(name, "data"))
+		}
+	}
+	find(templateData, sdk.TemplatePath{})
+	if len(found) != 1 {
+		return nil
+	}
+	return found[0]
+}
+
+// getComponentSchemaField returns the field of the component at the path in the request schema,
+// following the schema of each parent component
+func getComponentSchemaField(requestSchema *sdk.CatalogItemRequestSchema, componentPath sdk.TemplatePath) *sdk.RequestSchemaField {
+	var field *sdk.RequestSchemaField
+	for _, element := range componentPath {
+		name, ok := element.(string)
+		if !ok || name == "data" {
+			continue
+		}
+		if field != nil {
+			requestSchema = field.DataType.Schema
+		}
+		field = requestSchema.GetField(name)
+		if field == nil {
+			return nil
+		}
+	}
+	return field
+}
+
+// getDeploymentComponentName returns the component_name of a resource of a deployment, the name of the
+// component prefixed with the names of its parent components, for e.g., App.Web.Tomcat
+func getDeploymentComponentName(component sdk.DeploymentComponents, componentsByID map[string]sdk.DeploymentComponents) string {
+	name, _ := component.Data["Component"].(string)
+	if name == "" {
+		return ""
+	}
+	visited := map[string]bool{component.ID: true}
+	for parent, ok := componentsByID[component.ParentID]; ok && !visited[parent.ID]; parent, ok = componentsByID[parent.ParentID] {
+		visited[parent.ID] = true
+		parentName, _ := parent.Data["Component"].(string)
+		if parentName == "" {
+			break
+		}
+		name = parentName + ComponentNameSeparator + name
+	}
+	return name
+}
+
+// getConfiguredComponentName returns the component_name of a resource of a deployment as it is configured
+// in resource_configuration, its hierarchical name or, when only that one is configured, the name of the
+// component alone that was used for the components of nested blueprints
+func getConfiguredComponentName(component sdk.DeploymentComponents, componentsByID map[string]sdk.DeploymentComponents,
+	configured []sdk.ResourceConfigurationStruct) string {
+	name := getDeploymentComponentName(component, componentsByID)
+	if index, _ := GetResourceConfigurationByComponent(configured, name); index != -1 {
+		return name
+	}
+	ownName, _ := component.Data["Component"].(string)
+	if index, _ := GetResourceConfigurationByComponent(configured, ownName); index != -1 {
+		return ownName
+	}
+	return name
+}
+
+// getDeploymentComponentsByID returns the resources of the deployment by their id
+func getDeploymentComponentsByID(components []sdk.DeploymentComponents) map[string]sdk.DeploymentComponents {
+	componentsByID := make(map[string]sdk.DeploymentComponents, len(components))
+	for _, component := range components {
+		componentsByID[component.ID] = component
+	}
+	return componentsByID
+}
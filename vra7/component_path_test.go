@@ -0,0 +1,140 @@
+package vra7
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+var nestedTemplateData = `{
+	"_leaseDays": 1,
+	"machine2.vsphere": {"componentTypeId": "com.vmware.csp.component.cafe.composition", "data": {"cpu": 1}},
+	"App": {
+		"componentTypeId": "com.vmware.csp.component.cafe.composition",
+		"data": {
+			"Web": {
+				"componentTypeId": "com.vmware.csp.component.cafe.composition",
+				"data": {
+					"_cluster": 1,
+					"cpu": 1,
+					"Tomcat": {"componentTypeId": "com.vmware.csp.component.software", "data": {"install_path": "/opt/tomcat"}}
+				}
+			}
+		}
+	}
+}`
+
+func TestGetComponentPath(t *testing.T) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(nestedTemplateData), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	utils.AssertEqualsString(t, `["machine2.vsphere"]`, getComponentPath(data, "machine2.vsphere").String())
+	utils.AssertEqualsString(t, "App", getComponentPath(data, "App").String())
+	utils.AssertEqualsString(t, "App.data.Web", getComponentPath(data, "App.Web").String())
+	utils.AssertEqualsString(t, "App.data.Web.data.Tomcat", getComponentPath(data, "App.Web.Tomcat").String())
+
+	utils.AssertTrue(t, "Web is not a top level component", getComponentPath(data, "Web") == nil)
+	utils.AssertTrue(t, "Apache is not a software component of Web", getComponentPath(data, "App.Web.Apache") == nil)
+	utils.AssertTrue(t, "_leaseDays is not a component", getComponentPath(data, "_leaseDays") == nil)
+
+	// the properties are set in the data of the nested component
+	path := getComponentPath(data, "App.Web.Tomcat").Append("data")
+	utils.AssertNilError(t, AddValueToRequestTemplate(data, path, "install_path", "/usr/local/tomcat"))
+	value, err := sdk.TemplateData(data).Get(path.Append("install_path"))
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "/usr/local/tomcat", value.(string))
+}
+
+func TestMergeIntoNestedComponents(t *testing.T) {
+	var requestTemplate sdk.CatalogItemRequestTemplate
+	utils.AssertNilError(t, json.Unmarshal([]byte(`{"data": `+nestedTemplateData+`}`), &requestTemplate))
+
+	// the properties of a parent component never reach its children
+	p := &ProviderSchema{
+		ResourceConfiguration: []sdk.ResourceConfigurationStruct{
+			{ComponentName: "App", Configuration: map[string]interface{}{"cpu": "4", "install_path": "/usr/local/tomcat"}},
+			{ComponentName: "App.Web", Cluster: 2},
+		},
+	}
+	utils.AssertNilError(t, p.mergeIntoRequestTemplate(&requestTemplate))
+	app := requestTemplate.Data["App"].(map[string]interface{})["data"].(map[string]interface{})
+	utils.AssertEqualsInt(t, 4, convToInt(app["cpu"]))
+	utils.AssertEqualsString(t, "/usr/local/tomcat", app["install_path"].(string))
+	web := app["Web"].(map[string]interface{})["data"].(map[string]interface{})
+	utils.AssertEqualsInt(t, 1, convToInt(web["cpu"]))
+	utils.AssertEqualsInt(t, 2, convToInt(web[sdk.Cluster]))
+	tomcat := web["Tomcat"].(map[string]interface{})["data"].(map[string]interface{})
+	utils.AssertEqualsString(t, "/opt/tomcat", tomcat["install_path"].(string))
+}
+
+func TestLookupComponentPath(t *testing.T) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(nestedTemplateData), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	utils.AssertEqualsString(t, "App.data.Web", lookupComponentPath(data, "App.Web").String())
+	// the name of a nested component alone still addresses it
+	utils.AssertEqualsString(t, "App.data.Web", lookupComponentPath(data, "Web").String())
+	utils.AssertEqualsString(t, "App.data.Web.data.Tomcat", lookupComponentPath(data, "Tomcat").String())
+	utils.AssertTrue(t, "Apache is not a component", lookupComponentPath(data, "Apache") == nil)
+
+	// a name shared by several nested components is ambiguous
+	data["Db"] = map[string]interface{}{"data": map[string]interface{}{
+		"Web": map[string]interface{}{"data": map[string]interface{}{"cpu": 1}},
+	}}
+	utils.AssertTrue(t, "Web is ambiguous", lookupComponentPath(data, "Web") == nil)
+	utils.AssertEqualsString(t, "Db.data.Web", lookupComponentPath(data, "Db.Web").String())
+}
+
+func TestGetComponentSchemaField(t *testing.T) {
+	var requestSchema *sdk.CatalogItemRequestSchema
+	err := json.Unmarshal([]byte(`{"fields": [{"id": "App", "dataType": {"schema": {"fields": [
+		{"id": "Web", "dataType": {"schema": {"fields": [{"id": "_cluster"}]}}}
+	]}}}]}`), &requestSchema)
+	utils.AssertNilError(t, err)
+
+	field := getComponentSchemaField(requestSchema, sdk.TemplatePath{"App", "data", "Web"})
+	utils.AssertTrue(t, "Web field found", field != nil)
+	utils.AssertEqualsString(t, "Web", field.ID)
+	utils.AssertTrue(t, "_cluster of Web found", field.DataType.Schema.GetField(sdk.Cluster) != nil)
+	utils.AssertTrue(t, "Tomcat not found", getComponentSchemaField(requestSchema, sdk.TemplatePath{"App", "data", "Web", "data", "Tomcat"}) == nil)
+	utils.AssertTrue(t, "no schema", getComponentSchemaField(nil, sdk.TemplatePath{"App"}) == nil)
+}
+
+func TestGetDeploymentComponentName(t *testing.T) {
+	components := []sdk.DeploymentComponents{
+		{ID: "1", ParentID: "deployment", Data: map[string]interface{}{"Component": "App"}},
+		{ID: "2", ParentID: "1", Data: map[string]interface{}{"Component": "Web"}},
+		{ID: "3", ParentID: "2", Data: map[string]interface{}{"Component": "Tomcat"}},
+		{ID: "4", ParentID: "deployment", Data: map[string]interface{}{"Component": "machine2.vsphere"}},
+		{ID: "5", ParentID: "deployment", Data: map[string]interface{}{}},
+	}
+	componentsByID := getDeploymentComponentsByID(components)
+
+	utils.AssertEqualsString(t, "App", getDeploymentComponentName(components[0], componentsByID))
+	utils.AssertEqualsString(t, "App.Web", getDeploymentComponentName(components[1], componentsByID))
+	utils.AssertEqualsString(t, "App.Web.Tomcat", getDeploymentComponentName(components[2], componentsByID))
+	utils.AssertEqualsString(t, "machine2.vsphere", getDeploymentComponentName(components[3], componentsByID))
+	utils.AssertEqualsString(t, "", getDeploymentComponentName(components[4], componentsByID))
+}
+
+func TestGetConfiguredComponentName(t *testing.T) {
+	components := []sdk.DeploymentComponents{
+		{ID: "1", ParentID: "deployment", Data: map[string]interface{}{"Component": "App"}},
+		{ID: "2", ParentID: "1", Data: map[string]interface{}{"Component": "Web"}},
+		{ID: "3", ParentID: "1", Data: map[string]interface{}{"Component": "Db"}},
+	}
+	componentsByID := getDeploymentComponentsByID(components)
+	configured := []sdk.ResourceConfigurationStruct{{ComponentName: "Web"}, {ComponentName: "App.Db"}}
+
+	// the state of a deployment read before the hierarchical names keeps the name of the component alone
+	utils.AssertEqualsString(t, "Web", getConfiguredComponentName(components[1], componentsByID, configured))
+	utils.AssertEqualsString(t, "App.Db", getConfiguredComponentName(components[2], componentsByID, configured))
+	utils.AssertEqualsString(t, "App", getConfiguredComponentName(components[0], componentsByID, configured))
+	utils.AssertEqualsString(t, "App.Web", getConfiguredComponentName(components[1], componentsByID, nil))
+}
@@ -0,0 +1,80 @@
+package vra7
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// content package error constants
+const (
+	ContentPackageValidationError = "The content package %s is not valid:\n%s"
+	ContentPackageImportError     = "The import of the content package %s failed:\n%s"
+	ContentPackageIDError         = "The import of the content package %s did not return the id of the imported package"
+	ContentNotFoundError          = "No content %s of type %s is found"
+	AmbiguousContentError         = "Several contents %s of type %s are found: %s"
+)
+
+// readContentPackage returns the zip of the package at the path and its SHA-256 checksum
+func readContentPackage(path string) ([]byte, string, error) {
+	zip, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(zip)
+	return zip, hex.EncodeToString(sum[:]), nil
+}
+
+func contentPackageFileName(path string) string {
+	return filepath.Base(path)
+}
+
+func contentOperationResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"messages": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func flattenContentOperationResults(results []sdk.ContentOperationResult) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		flattened = append(flattened, map[string]interface{}{
+			"content_id": result.ContentID,
+			"name":       result.ContentName,
+			"type":       result.ContentTypeID,
+			"status":     result.OperationStatusType,
+			"messages":   append(append([]string{}, result.OperationErrors...), result.Messages...),
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,127 @@
+package vra7
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestResourceVra7ContentPackageCreate(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	dir, err := ioutil.TempDir("", "content-package")
+	utils.AssertNilError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "package.zip")
+	utils.AssertNilError(t, ioutil.WriteFile(path, []byte("zip"), 0644))
+	_, checksum, err := readContentPackage(path)
+	utils.AssertNilError(t, err)
+
+	id := "7c8a4b3e-5d6f-4a1b-8c9d-0e1f2a3b4c5d"
+	packageURL := client.BuildEncodedURL(fmt.Sprintf(sdk.PackageAPI, id), nil)
+	importURL := client.BuildEncodedURL(sdk.PackagesAPI, map[string]string{"resolutionMode": sdk.ResolutionModeSkip})
+	httpmock.RegisterResponder("POST", importURL, func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(200, `{"operationStatus":"SUCCESS",
+		"operationResults":[{"contentId":"BasicSingleMachine","contentName":"Basic Single Machine","contentTypeId":"composite-blueprint",
+		"operationStatusType":"SUCCESS","messages":["The content is imported"]}]}`)
+		resp.Header.Set("Location", packageURL)
+		return resp, nil
+	})
+
+	// the package is not imported when its validation fails
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(sdk.ValidatePackageAPI, nil), httpmock.NewStringResponder(200, `{"operationStatus":"FAILED",
+		"operationResults":[{"contentName":"Basic Single Machine","contentTypeId":"composite-blueprint","operationStatusType":"FAILED",
+		"operationErrors":["The reservation policy Gold does not exist"]}]}`))
+	d := schema.TestResourceDataRaw(t, resourceVra7ContentPackage().Schema, map[string]interface{}{"file_path": path})
+	err = resourceVra7ContentPackageCreate(d, &client)
+	utils.AssertNotNilError(t, err)
+	utils.AssertContainsString(t, "The reservation policy Gold does not exist", err.Error())
+	utils.AssertEqualsString(t, "", d.Id())
+	utils.AssertEqualsInt(t, 0, httpmock.GetCallCountInfo()["POST "+importURL])
+
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(sdk.ValidatePackageAPI, nil), httpmock.NewStringResponder(200, `{"operationStatus":"SUCCESS",
+		"operationResults":[]}`))
+	utils.AssertNilError(t, resourceVra7ContentPackageCreate(d, &client))
+	utils.AssertEqualsString(t, id, d.Id())
+	utils.AssertEqualsString(t, checksum, d.Get("file_sha256").(string))
+	utils.AssertEqualsString(t, "BasicSingleMachine", d.Get("imported_content.0.content_id").(string))
+	utils.AssertEqualsString(t, "The content is imported", d.Get("imported_content.0.messages.0").(string))
+
+	// the read does not need the zip, and drops the package deleted in vRA
+	utils.AssertNilError(t, os.Remove(path))
+	httpmock.RegisterResponder("GET", packageURL, httpmock.NewStringResponder(200, `{"id":"`+id+`","name":"package"}`))
+	utils.AssertNilError(t, resourceVra7ContentPackageRead(d, &client))
+	utils.AssertEqualsString(t, id, d.Id())
+	httpmock.RegisterResponder("GET", packageURL, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7ContentPackageRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
+
+func TestDataSourceVra7ContentPackageRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	contentsURL := func(name string) string {
+		return client.BuildEncodedURL(sdk.ContentsAPI, map[string]string{
+			"$filter": "name eq '" + name + "' and contentTypeId eq 'composite-blueprint'",
+			"page":    "1"})
+	}
+	httpmock.RegisterResponder("GET", contentsURL("Basic Single Machine"),
+		httpmock.NewStringResponder(200, `{"content":[{"id":"c1","name":"Basic Single Machine"}],"metadata":{"totalPages":1}}`))
+	httpmock.RegisterResponder("GET", contentsURL("Missing"),
+		httpmock.NewStringResponder(200, `{"content":[],"metadata":{"totalPages":0}}`))
+
+	id := "7c8a4b3e-5d6f-4a1b-8c9d-0e1f2a3b4c5d"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.PackageAPI, id), nil)
+	httpmock.RegisterResponder("POST", client.BuildEncodedURL(sdk.PackagesAPI, nil),
+		httpmock.NewStringResponder(201, `{"id":"`+id+`","name":"promotion","contents":["c1"]}`))
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, "zip"))
+	httpmock.RegisterResponder("DELETE", url, httpmock.NewStringResponder(204, ""))
+
+	dir, err := ioutil.TempDir("", "content-package")
+	utils.AssertNilError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "promotion.zip")
+
+	d := schema.TestResourceDataRaw(t, dataSourceVra7ContentPackage().Schema, map[string]interface{}{
+		"name":        "promotion",
+		"output_path": path,
+		"content": []interface{}{
+			map[string]interface{}{"type": "composite-blueprint", "name": "Missing"},
+		},
+	})
+	err = dataSourceVra7ContentPackageRead(d, &client)
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(ContentNotFoundError, "Missing", "composite-blueprint"), err.Error())
+
+	d = schema.TestResourceDataRaw(t, dataSourceVra7ContentPackage().Schema, map[string]interface{}{
+		"name":        "promotion",
+		"output_path": path,
+		"content": []interface{}{
+			map[string]interface{}{"type": "composite-blueprint", "name": "Basic Single Machine"},
+		},
+	})
+	utils.AssertNilError(t, dataSourceVra7ContentPackageRead(d, &client))
+	zip, err := ioutil.ReadFile(path)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "zip", string(zip))
+	utils.AssertEqualsString(t, "c1", d.Get("content_ids.0").(string))
+	utils.AssertEqualsString(t, d.Id(), d.Get("output_sha256").(string))
+	utils.AssertEqualsInt(t, 1, httpmock.GetCallCountInfo()["DELETE "+url])
+}
@@ -0,0 +1,57 @@
+package vra7
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// dataSourceVra7ApprovalPolicy looks up an approval policy by its name, for e.g., to reference a policy that is
+// managed outside terraform from an entitlement
+func dataSourceVra7ApprovalPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVra7ApprovalPolicyRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVra7ApprovalPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	vraClient := meta.(*sdk.APIClient)
+
+	name := d.Get("name").(string)
+	policies, err := vraClient.FindApprovalPolicies(name)
+	if err != nil {
+		return err
+	}
+	switch len(policies) {
+	case 0:
+		return fmt.Errorf(ApprovalPolicyNotFoundError, name)
+	case 1:
+	default:
+		return fmt.Errorf(AmbiguousApprovalPolicyError, name)
+	}
+	policy := policies[0]
+	d.SetId(policy.ID)
+	d.Set("description", policy.Description)
+	d.Set("type", policy.PolicyTypeID)
+	d.Set("status", policy.State)
+	return nil
+}
@@ -0,0 +1,127 @@
+package vra7
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func dataSourceVra7ContentPackage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVra7ContentPackageRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceVra7ContentPackageRead exports the contents in a package zip at the output path. The package is
+// only created in vRA for the export, and is deleted once the zip is downloaded.
+func dataSourceVra7ContentPackageRead(d *schema.ResourceData, meta interface{}) error {
+	vraClient := meta.(*sdk.APIClient)
+
+	contentIDs, err := findContentIDs(vraClient, d.Get("content").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	contentPackage, err := vraClient.CreateContentPackage(&sdk.ContentPackage{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Contents:    contentIDs,
+	})
+	if err != nil {
+		return err
+	}
+	zip, err := vraClient.ExportContentPackage(contentPackage.ID)
+	if deleteErr := vraClient.DeleteContentPackage(contentPackage.ID); deleteErr != nil {
+		log.Warning("The content package %s could not be deleted after its export: %v", contentPackage.ID, deleteErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	path := d.Get("output_path").(string)
+	if err := ioutil.WriteFile(path, zip, 0644); err != nil {
+		return err
+	}
+	_, checksum, err := readContentPackage(path)
+	if err != nil {
+		return err
+	}
+	log.Info("Exported the content package %s to %s", d.Get("name").(string), path)
+
+	d.SetId(checksum)
+	d.Set("output_sha256", checksum)
+	if err := d.Set("content_ids", contentIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// findContentIDs returns the ids of the contents of the content blocks, each block has to match one content
+func findContentIDs(vraClient *sdk.APIClient, contentBlocks []interface{}) ([]string, error) {
+	contentIDs := make([]string, 0, len(contentBlocks))
+	for _, block := range contentBlocks {
+		contentMap := block.(map[string]interface{})
+		contentType := contentMap["type"].(string)
+		name := contentMap["name"].(string)
+		contents, err := vraClient.FindContents(contentType, name)
+		if err != nil {
+			return nil, err
+		}
+		switch len(contents) {
+		case 0:
+			return nil, fmt.Errorf(ContentNotFoundError, name, contentType)
+		case 1:
+			contentIDs = append(contentIDs, contents[0].ID)
+			continue
+		}
+		ids := make([]string, 0, len(contents))
+		for _, content := range contents {
+			ids = append(ids, content.ID)
+		}
+		return nil, fmt.Errorf(AmbiguousContentError, name, contentType, strings.Join(ids, ", "))
+	}
+	return contentIDs, nil
+}
@@ -2,6 +2,7 @@ package vra7
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/vmware/terraform-provider-vra7/sdk"
@@ -67,6 +68,27 @@ func dataSourceVra7Deployment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"days_until_expiry": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lease_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"terraform_marker": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"terraform_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"xaas_resources": xaasResourcesSchema(),
 			"owners": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -107,12 +129,14 @@ func dataSourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	requestID := ""
+	status := ""
 	if deploymentID.(string) != "" {
 		resource, err := vraClient.GetResource(deploymentID.(string))
 		if err != nil {
 			return err
 		}
 		requestID = resource.RequestID
+		status = resource.Status
 	}
 
 	// Since the resource view API above do not provide the cluster value, it is calculated
@@ -131,13 +155,24 @@ func dataSourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("catalog_item_id", deployment.CatalogItem.ID)
 	d.Set("catalog_item_name", deployment.CatalogItem.Label)
 	d.Set("deployment_id", deploymentID)
-	d.Set("description", deployment.Description)
+	description, marker := parseDescription(deployment.Description)
+	d.Set("description", description)
+	d.Set("terraform_managed", marker != "")
+	d.Set("terraform_marker", marker)
 	d.Set("created_date", deployment.CreatedDate)
 	d.Set("expiry_date", deployment.ExpiryDate)
 	d.Set("name", deployment.Name)
 	d.Set("businessgroup_id", deployment.Subtenant.ID)
 	d.Set("businessgroup_name", deployment.Subtenant.Label)
 
+	days, err := daysUntilExpiry(deployment.ExpiryDate, time.Now())
+	if err != nil {
+		return err
+	}
+	d.Set("days_until_expiry", days)
+	d.Set("status", status)
+	d.Set("lease_state", leaseState(status, deployment.ExpiryDate, time.Now()))
+
 	owners := make([]map[string]string, 0)
 	for _, owner := range deployment.Owners {
 		ownerMap := make(map[string]string)
@@ -147,20 +182,20 @@ func dataSourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	d.Set("owners", owners)
 
+	componentsByID := getDeploymentComponentsByID(deployment.Components)
+	xaasResources := make([]sdk.XaaSResource, 0)
 	for _, component := range deployment.Components {
 
+		if isXaaSResourceType(component.Type) {
+			xaasResources = append(xaasResources, newXaaSResourceFromComponent(component, getDeploymentComponentName(component, componentsByID)))
+		}
+
 		if component.Type == sdk.InfrastructureVirtual {
 
-			data := component.Data
-			componentName := data["Component"].(string)
+			componentName := getDeploymentComponentName(component, componentsByID)
 
 			if componentName != "" {
-				instance := sdk.Instance{}
-				instance.IPAddress = data["ip_address"].(string)
-				instance.Name = component.Name
-				instance.ResourceID = component.ID
-				instance.ResourceType = component.Type
-				instance.Properties = data
+				instance := newInstance(component)
 
 				// checking to see if a resource configuration struct exists for the component name
 				// if yes, then add another instance to the instances list of that resource config struct
@@ -187,6 +222,9 @@ func dataSourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("resource_configuration", flattenResourceConfigurations(resourceConfigList, clusterCountMap)); err != nil {
 		return fmt.Errorf("error setting resource configuration - error: %v", err)
 	}
+	if err := d.Set("xaas_resources", flattenXaaSResources(xaasResources)); err != nil {
+		return fmt.Errorf("error setting xaas resources - error: %v", err)
+	}
 
 	d.SetId(requestID)
 
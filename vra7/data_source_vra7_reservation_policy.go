@@ -0,0 +1,57 @@
+package vra7
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func dataSourceVra7ReservationPolicy() *schema.Resource {
+	return reservationPolicyDataSource(sdk.ReservationPolicyTypeCompute)
+}
+
+func dataSourceVra7StorageReservationPolicy() *schema.Resource {
+	return reservationPolicyDataSource(sdk.ReservationPolicyTypeStorage)
+}
+
+// reservationPolicyDataSource returns the data source that looks up a reservation policy of the type by its name
+func reservationPolicyDataSource(policyType string) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return reservationPolicyDataSourceRead(d, meta, policyType)
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func reservationPolicyDataSourceRead(d *schema.ResourceData, meta interface{}, policyType string) error {
+	vraClient := meta.(*sdk.APIClient)
+
+	name := d.Get("name").(string)
+	policies, err := vraClient.FindReservationPolicies(name, policyType)
+	if err != nil {
+		return err
+	}
+	switch len(policies) {
+	case 0:
+		return fmt.Errorf(ReservationPolicyNotFoundError, reservationPolicyTypeNames[policyType], name)
+	case 1:
+		d.SetId(policies[0].ID)
+		return setReservationPolicy(d, &policies[0])
+	}
+	return fmt.Errorf(AmbiguousReservationPolicyError, reservationPolicyTypeNames[policyType], name)
+}
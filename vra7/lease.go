@@ -0,0 +1,203 @@
+package vra7
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// lease constants
+const (
+	// ExpiryDateFormat is the format of the expiry_date, for e.g., 2020-11-25T20:29:37.845Z
+	ExpiryDateFormat = "2006-01-02T15:04:05.000Z07:00"
+	// LeaseExpiryWarningDays is the number of days before the expiry a warning is logged,
+	// unless auto_extend_within_days is set
+	LeaseExpiryWarningDays = 7
+	// NoExpiry is the value of days_until_expiry when the lease never expires
+	NoExpiry = -1
+
+	// lease states of a deployment
+	LeaseStateActive   = "active"
+	LeaseStateExpired  = "expired"
+	LeaseStateArchived = "archived"
+
+	AutoExtendWithoutLeaseError = "auto_extend_within_days requires lease_days to be set, the lease is extended by lease_days"
+	DeploymentNotActiveError    = "The deployment %s is %s, its machines are powered off and day-2 operations are not available. " +
+		"Extend the lease in vRA and run terraform refresh, or set recreate_if_expired to replace the deployment"
+	DestroyActionNotFoundError = "The action %s is not available on the deployment %s, set deployment_destroy to false to remove it from the state without destroying it"
+	LeaseOverDestroyError      = "The deployment %s is %s and the action %s is not available, vRA destroys it at the end of its archive period. " +
+		"Set deployment_destroy to false to remove it from the state without destroying it"
+	ChangeLeaseNotFoundError = "The action %s is not available on the deployment %s, its lease_days, expiry_date and auto extension cannot be applied"
+)
+
+// leaseExpiryDate returns the expiry date of a lease of leaseDays days starting at from.
+// A lease of 0 days never expires, so there is no expiry date
+func leaseExpiryDate(leaseDays int, from time.Time) interface{} {
+	if leaseDays == 0 {
+		return nil
+	}
+	return from.UTC().AddDate(0, 0, leaseDays).Format(ExpiryDateFormat)
+}
+
+// daysUntilExpiry returns the number of whole days left before the expiry date,
+// or NoExpiry if the deployment has no expiry date
+func daysUntilExpiry(expiryDate string, now time.Time) (int, error) {
+	if expiryDate == "" {
+		return NoExpiry, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, expiryDate)
+	if err != nil {
+		return NoExpiry, fmt.Errorf("The expiry date %s is not valid: %v", expiryDate, err)
+	}
+	days := math.Floor(expiry.Sub(now).Hours() / 24)
+	if days < 0 {
+		return 0, nil
+	}
+	return int(days), nil
+}
+
+// leaseState returns the lease state of a deployment from the status of its catalog resource and its expiry date.
+// A deployment whose expiry date is past is expired, even if its status is not updated yet
+func leaseState(status, expiryDate string, now time.Time) string {
+	switch strings.ToUpper(status) {
+	case sdk.ResourceStatusArchived:
+		return LeaseStateArchived
+	case sdk.ResourceStatusExpired:
+		return LeaseStateExpired
+	}
+	if expiryDate == "" {
+		return LeaseStateActive
+	}
+	expiry, err := time.Parse(time.RFC3339, expiryDate)
+	if err == nil && !expiry.After(now) {
+		return LeaseStateExpired
+	}
+	return LeaseStateActive
+}
+
+// isLeaseOver returns true if the deployment is expired or archived
+func isLeaseOver(state string) bool {
+	return state == LeaseStateExpired || state == LeaseStateArchived
+}
+
+// checkDeploymentActive returns an error if the lease of the deployment read during the last refresh is over
+func checkDeploymentActive(d *schema.ResourceData) error {
+	state := d.Get("lease_state").(string)
+	if isLeaseOver(state) {
+		return fmt.Errorf(DeploymentNotActiveError, d.Get("deployment_id").(string), state)
+	}
+	return nil
+}
+
+// isAutoExtendDue returns true if the lease is to be extended because it expires within autoExtendWithinDays days
+func isAutoExtendDue(expiryDate string, autoExtendWithinDays int, now time.Time) bool {
+	if autoExtendWithinDays <= 0 {
+		return false
+	}
+	days, err := daysUntilExpiry(expiryDate, now)
+	if err != nil || days == NoExpiry {
+		return false
+	}
+	return days <= autoExtendWithinDays
+}
+
+// warnIfLeaseExpiring logs a warning when the deployment expires within the warning period
+func warnIfLeaseExpiring(deploymentID string, days, autoExtendWithinDays int) {
+	warningDays := LeaseExpiryWarningDays
+	if autoExtendWithinDays > 0 {
+		warningDays = autoExtendWithinDays
+	}
+	if days != NoExpiry && days <= warningDays {
+		log.Warning("The lease of the deployment %s expires in %d day(s). Update lease_days or expiry_date, "+
+			"or set auto_extend_within_days to extend it.", deploymentID, days)
+	}
+}
+
+// getNewExpiryDate returns the expiry date the lease has to be changed to, and false if the lease does not change.
+// An updated expiry_date takes precedence over an updated lease_days, which takes precedence over the auto extension
+func getNewExpiryDate(d *schema.ResourceData, p *ProviderSchema, now time.Time) (interface{}, bool) {
+	if d.HasChange("expiry_date") {
+		return d.Get("expiry_date").(string), true
+	}
+	if d.HasChange("lease_days") {
+		return leaseExpiryDate(p.Lease, now), true
+	}
+	if isAutoExtendDue(d.Get("expiry_date").(string), p.AutoExtendWithinDays, now) && p.Lease > 0 {
+		log.Info("The lease of the deployment %s expires within %d day(s), extending it by %d day(s).",
+			p.DeploymentID, p.AutoExtendWithinDays, p.Lease)
+		return leaseExpiryDate(p.Lease, now), true
+	}
+	return nil, false
+}
+
+// changeLease runs the Change Lease action on the deployment to set its expiry date
+func changeLease(d *schema.ResourceData, meta interface{}, deploymentID string, expiryDate interface{}) error {
+	vraClient := meta.(*sdk.APIClient)
+	deploymentResourceActions, err := vraClient.GetResourceActions(deploymentID)
+	if err != nil {
+		return err
+	}
+	deploymentActionsMap := GetActionNameIDMap(deploymentResourceActions)
+	changeLeaseActionID := deploymentActionsMap[sdk.ChangeLease]
+	if changeLeaseActionID == "" {
+		return fmt.Errorf(ChangeLeaseNotFoundError, sdk.ChangeLease, deploymentID)
+	}
+	resourceActionTemplate, err := vraClient.GetResourceActionTemplate(deploymentID, changeLeaseActionID)
+	if err != nil {
+		return err
+	}
+	log.Info("Starting Change Lease action on the deployment with id %v. The new expiry date is %v.", deploymentID, expiryDate)
+	replaced, err := ReplaceValueInRequestTemplate(resourceActionTemplate.Data, nil, sdk.ProviderExpirationDate, expiryDate)
+	if err != nil {
+		return err
+	}
+	if !replaced {
+		return fmt.Errorf(sdk.TemplatePathNotFoundError, sdk.ProviderExpirationDate)
+	}
+	resourceActionTemplate.Description = d.Get("description").(string)
+	resourceActionTemplate.Reasons = d.Get("reasons").(string)
+	requestID, err := vraClient.PostResourceAction(deploymentID, changeLeaseActionID, resourceActionTemplate)
+	if err != nil {
+		log.Errorf("The change lease request failed with error: %v ", err)
+		return err
+	}
+	_, err = waitForRequestCompletion(d, meta, requestID)
+	if err != nil {
+		log.Errorf("The change lease request failed with error: %v ", err)
+		return err
+	}
+	log.Info("Successfully completed the Change Lease action for the deployment with id %v.", deploymentID)
+	return nil
+}
+
+// checkLeaseAutoExtension validates auto_extend_within_days and plans the update that extends the lease when it is due
+func checkLeaseAutoExtension(d *schema.ResourceDiff, now time.Time) error {
+	autoExtendWithinDays := d.Get("auto_extend_within_days").(int)
+	if autoExtendWithinDays <= 0 {
+		return nil
+	}
+	if d.Get("lease_days").(int) <= 0 {
+		return fmt.Errorf(AutoExtendWithoutLeaseError)
+	}
+	if d.Id() != "" && !isLeaseOver(d.Get("lease_state").(string)) && isAutoExtendDue(d.Get("expiry_date").(string), autoExtendWithinDays, now) {
+		return d.SetNewComputed("days_until_expiry")
+	}
+	return nil
+}
+
+// checkRecreateIfExpired plans the replacement of an expired or archived deployment when recreate_if_expired is set
+func checkRecreateIfExpired(d *schema.ResourceDiff) error {
+	state := d.Get("lease_state").(string)
+	if !d.Get("recreate_if_expired").(bool) || !isLeaseOver(state) {
+		return nil
+	}
+	log.Info("The deployment %s is %s, planning its replacement.", d.Get("deployment_id").(string), state)
+	if err := d.SetNew("lease_state", LeaseStateActive); err != nil {
+		return err
+	}
+	return d.ForceNew("lease_state")
+}
@@ -0,0 +1,71 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestLeaseExpiryDate(t *testing.T) {
+	now := time.Date(2020, 11, 17, 19, 53, 16, 124000000, time.UTC)
+	utils.AssertEqualsString(t, "2020-11-27T19:53:16.124Z", leaseExpiryDate(10, now).(string))
+	utils.AssertTrue(t, "no expiry date", leaseExpiryDate(0, now) == nil)
+}
+
+func TestDaysUntilExpiry(t *testing.T) {
+	now := time.Date(2020, 11, 17, 19, 53, 16, 0, time.UTC)
+
+	days, err := daysUntilExpiry("2020-11-25T20:29:37.845Z", now)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 8, days)
+
+	days, err = daysUntilExpiry("2020-11-10T20:29:37.845Z", now)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 0, days)
+
+	days, err = daysUntilExpiry("", now)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, NoExpiry, days)
+
+	_, err = daysUntilExpiry("next week", now)
+	utils.AssertNotNilError(t, err)
+
+	utils.AssertTrue(t, "auto extend due", isAutoExtendDue("2020-11-20T20:29:37.845Z", 3, now))
+	utils.AssertFalse(t, "auto extend due", isAutoExtendDue("2020-11-25T20:29:37.845Z", 3, now))
+	utils.AssertFalse(t, "auto extend due", isAutoExtendDue("2020-11-20T20:29:37.845Z", 0, now))
+	utils.AssertFalse(t, "auto extend due", isAutoExtendDue("", 3, now))
+}
+
+func TestLeaseState(t *testing.T) {
+	now := time.Date(2020, 11, 17, 19, 53, 16, 0, time.UTC)
+
+	utils.AssertEqualsString(t, LeaseStateActive, leaseState(sdk.ResourceStatusActive, "2020-11-25T20:29:37.845Z", now))
+	utils.AssertEqualsString(t, LeaseStateActive, leaseState(sdk.ResourceStatusActive, "", now))
+	utils.AssertEqualsString(t, LeaseStateExpired, leaseState(sdk.ResourceStatusActive, "2020-11-10T20:29:37.845Z", now))
+	utils.AssertEqualsString(t, LeaseStateExpired, leaseState(sdk.ResourceStatusExpired, "2020-11-25T20:29:37.845Z", now))
+	utils.AssertEqualsString(t, LeaseStateArchived, leaseState(sdk.ResourceStatusArchived, "2020-11-10T20:29:37.845Z", now))
+
+	utils.AssertTrue(t, "lease over", isLeaseOver(LeaseStateArchived))
+	utils.AssertFalse(t, "lease over", isLeaseOver(LeaseStateActive))
+	utils.AssertFalse(t, "lease over", isLeaseOver(""))
+}
+
+func TestChangeLeaseActionNotFound(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+	httpmock.RegisterResponder("GET", client.BuildEncodedURL(fmt.Sprintf(sdk.ResourceActions, "deployment-1"), nil),
+		httpmock.NewStringResponder(200, `{"content": []}`))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7Deployment().Schema, map[string]interface{}{})
+	err := changeLease(d, &client, "deployment-1", "2026-12-01T00:00:00Z")
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(ChangeLeaseNotFoundError, sdk.ChangeLease, "deployment-1"), err.Error())
+}
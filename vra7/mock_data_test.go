@@ -157,4 +157,380 @@ var (
 		   }
 		}
 	 }`
+
+	mockReconfigureActionTemplateData = `{
+		"allowForceShutdown":"false",
+		"cpu":1,
+		"description":null,
+		"disks":[
+		   {
+			  "componentTypeId":"com.vmware.csp.component.iaas.proxy.provider",
+			  "componentId":null,
+			  "classId":"Infrastructure.Compute.Machine.MachineDisk",
+			  "typeFilter":null,
+			  "data":{
+				 "customProperties":[],
+				 "driveLetter":null,
+				 "externalId":"6000C29d-a1aa-db33-800f-6c1a1c725057",
+				 "label":"Hard disk 1",
+				 "reservationPolicy":null,
+				 "reservationPolicyMode":null,
+				 "size":8,
+				 "storagePath":"wdc-eso-ins-a-VMs-1"
+			  }
+		   }
+		],
+		"executionSelector":"1",
+		"memory":1024,
+		"name":"Development0234",
+		"nics":[
+		   {
+			  "componentTypeId":"com.vmware.csp.component.iaas.proxy.provider",
+			  "componentId":null,
+			  "classId":"Infrastructure.Compute.Machine.Nic",
+			  "typeFilter":null,
+			  "data":{
+				 "macAddress":"00:50:56:b6:8a:02",
+				 "name":"dvPortGroup-wdc-sdm-vm-1521"
+			  }
+		   }
+		],
+		"powerActionSelector":"0",
+		"requestor":"APIUser",
+		"storage":8
+	 }`
+
+	mockRequestSchema = `{
+		"classId":"Blueprint.Component.Declaration",
+		"typeFilter":null,
+		"fields":[
+		   {
+			  "id":"_leaseDays",
+			  "label":"Lease days",
+			  "dataType":{
+				 "type":"primitive",
+				 "typeId":"INTEGER"
+			  },
+			  "state":{
+				 "dependencies":[],
+				 "facets":[
+					{
+					   "type":"minValue",
+					   "value":{
+						  "type":"constant",
+						  "value":{
+							 "type":"integer",
+							 "value":1
+						  }
+					   }
+					},
+					{
+					   "type":"maxValue",
+					   "value":{
+						  "type":"constant",
+						  "value":{
+							 "type":"integer",
+							 "value":30
+						  }
+					   }
+					}
+				 ]
+			  }
+		   },
+		   {
+			  "id":"mock.test.machine1",
+			  "label":"mock.test.machine1",
+			  "dataType":{
+				 "type":"complex",
+				 "classId":"Blueprint.Component.Declaration",
+				 "schema":{
+					"fields":[
+					   {
+						  "id":"_cluster",
+						  "label":"Instances",
+						  "dataType":{
+							 "type":"primitive",
+							 "typeId":"INTEGER"
+						  },
+						  "state":{
+							 "dependencies":[],
+							 "facets":[
+								{
+								   "type":"minValue",
+								   "value":{
+									  "type":"constant",
+									  "value":{
+										 "type":"integer",
+										 "value":1
+									  }
+								   }
+								},
+								{
+								   "type":"maxValue",
+								   "value":{
+									  "type":"constant",
+									  "value":{
+										 "type":"integer",
+										 "value":3
+									  }
+								   }
+								}
+							 ]
+						  }
+					   }
+					]
+				 }
+			  },
+			  "state":{
+				 "dependencies":[],
+				 "facets":[]
+			  }
+		   }
+		]
+	 }`
+
+	mockXaaSRequestTemplate = `{
+		"type":"com.vmware.vcac.catalog.domain.request.CatalogItemProvisioningRequest",
+		"catalogItemId":"xaas-dns-record",
+		"requestedFor":"admin@vsphere.local",
+		"businessGroupId":"6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+		"description":null,
+		"reasons":null,
+		"data":{
+			"hostname":null,
+			"zone":"example.com",
+			"_leaseDays":null
+		}
+	}`
+
+	mockXaaSResource = `{
+		"id":"b7d2b7d0-5e5a-4c35-9d6c-37d1a3b1e0b4",
+		"name":"web01.example.com",
+		"resourceTypeRef":{
+			"id":"Custom.DNSRecord",
+			"label":"DNS Record"
+		},
+		"status":"ACTIVE",
+		"requestId":"0d5e4d3c-8a77-4a5b-9f5c-3c7c8e2f1a10",
+		"resourceData":{
+			"entries":[
+				{"key":"fqdn","value":{"type":"string","value":"web01.example.com"}},
+				{"key":"ttl","value":{"type":"integer","value":300}},
+				{"key":"owner","value":null}
+			]
+		}
+	}`
+
+	mockEntitlement = `{
+		"id":"b2b5c2a4-1f6e-4c1a-9a3b-0b1c1d2e3f40",
+		"name":"Development",
+		"tenantRef":"vsphere.local",
+		"organization":{"tenantRef":"vsphere.local","subtenantRef":"6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a"},
+		"principals":[{"tenantName":"vsphere.local","ref":"developers@example.com","type":"GROUP","value":"developers"}],
+		"status":"INACTIVE",
+		"allUsers":false,
+		"localScopeForActions":true,
+		"entitledServices":[],
+		"entitledCatalogItems":[
+			{"catalogItemRef":{"id":"feaedf73-560c-4612-a573-41667e017691"},"approvalPolicyId":"c0ffee00-0000-4000-8000-000000000002","active":true}
+		],
+		"entitledResourceOperations":[
+			{"externalId":"Infrastructure.Machine.Action.PowerOn","resourceOperationType":"ACTION","targetResourceTypeRef":{"id":"Infrastructure.Virtual"},"active":true}
+		],
+		"version":3
+	}`
+
+	mockCatalogService = `{
+		"id":"a1b2c3d4-0000-4000-8000-000000000001",
+		"name":"Linux",
+		"description":"Linux machines",
+		"status":"INACTIVE",
+		"organization":{"tenantRef":"vsphere.local","tenantLabel":"vsphere.local"},
+		"owner":{"tenantName":"vsphere.local","ref":"jdoe@example.com","type":"USER","value":"John Doe"},
+		"supportTeam":null,
+		"hours":{"startTime":"08:00","endTime":"18:00"},
+		"version":2
+	}`
+
+	mockServiceCatalogItems = `{
+		"content":[
+			{"id":"feaedf73-560c-4612-a573-41667e017691","name":"CentOS 7.0 x64","serviceRef":{"id":"a1b2c3d4-0000-4000-8000-000000000001","label":"Linux"}},
+			{"id":"0b4e0bd8-f1b6-4b60-9d4a-5f6f7e3a2c11","name":"Ubuntu 18.04","serviceRef":{"id":"a1b2c3d4-0000-4000-8000-000000000001","label":"Linux"}}
+		],
+		"metadata":{"size":2,"totalElements":2,"totalPages":1,"number":1,"offset":0}
+	}`
+
+	mockBlueprintDocument = `{
+		"@type":"CompositeBlueprint",
+		"id":"BasicSingleMachine",
+		"name":"Basic Single Machine",
+		"description":"Basic vSphere Machine",
+		"status":"PUBLISHED",
+		"createdDate":"2019-03-25T20:33:49.123Z",
+		"lastUpdated":"2019-03-26T08:12:01.456Z",
+		"properties":{"_leaseDays":{"default":1,"max":60,"min":1}},
+		"components":{
+			"vSphereVM1":{
+				"type":"Infrastructure.CatalogItem.Machine.Virtual.vSphere",
+				"data":{
+					"cpu":{"default":1,"max":4,"min":1},
+					"memory":{"default":1240,"max":8192,"min":1240},
+					"nics":[{"id":0,"network":"${_resource~DefaultNetworkProfile}","assignment_type":"Static"}]
+				}
+			}
+		},
+		"layout":{"vSphereVM1":"0,1"}
+	}`
+
+	mockReservation = `{
+		"id":"5e1a6c2f-8d3b-4a7e-9f10-2b3c4d5e6f70",
+		"name":"Development-vSphere",
+		"reservationTypeId":"Infrastructure.Reservation.Virtual.vSphere",
+		"tenantId":"vsphere.local",
+		"subTenantId":"6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+		"enabled":true,
+		"priority":2,
+		"reservationPolicyId":"d1e2f3a4-0000-4000-8000-000000000003",
+		"alertPolicy":{"enabled":true,"frequencyReminder":7,"emailBgMgr":true,"recipients":["ops@example.com"],
+			"alerts":[{"id":"storage","referenceResourceId":"storage","alertPercentLevel":90},
+				{"id":"memory","referenceResourceId":"memory","alertPercentLevel":85},
+				{"id":"cpu","referenceResourceId":"cpu","alertPercentLevel":80},
+				{"id":"machine","referenceResourceId":"machine","alertPercentLevel":75}]},
+		"extensionData":{"entries":[
+			{"key":"computeResource","value":{"type":"entityRef","classId":"ComputeResource","id":"cr-1","label":"Cluster1"}},
+			{"key":"machineQuota","value":{"type":"integer","value":10}},
+			{"key":"reservationMemory","value":{"type":"complex","classId":"Infrastructure.Reservation.Memory","values":{"entries":[
+				{"key":"computeResourceMemoryTotalSizeMb","value":{"type":"integer","value":65536}},
+				{"key":"memoryReservedSizeMb","value":{"type":"integer","value":8192}}]}}},
+			{"key":"reservationStorages","value":{"type":"multiple","elementTypeId":"COMPLEX","items":[
+				{"type":"complex","classId":"Infrastructure.Reservation.Storage","values":{"entries":[
+					{"key":"storagePath","value":{"type":"entityRef","classId":"Storage","id":"ds-1","label":"datastore1"}},
+					{"key":"storageReservedSizeGB","value":{"type":"integer","value":100}},
+					{"key":"storageReservationPriority","value":{"type":"integer","value":1}},
+					{"key":"storageEnabled","value":{"type":"boolean","value":false}}]}}]}},
+			{"key":"reservationNetworks","value":{"type":"multiple","elementTypeId":"COMPLEX","items":[
+				{"type":"complex","classId":"Infrastructure.Reservation.Network","values":{"entries":[
+					{"key":"networkPath","value":{"type":"entityRef","classId":"Network","id":"net-1","label":"VM Network"}},
+					{"key":"networkProfile","value":{"type":"entityRef","classId":"NetworkProfile","id":"np-1","label":"Default"}}]}}]}},
+			{"key":"reservationVCNSTransportZone","value":null}
+		]},
+		"version":4
+	}`
+
+	mockReservationPolicies = `{
+		"links":[],
+		"content":[
+			{"id":"d1e2f3a4-0000-4000-8000-000000000003","name":"Gold","description":"Gold clusters",
+			"reservationPolicyTypeId":"Infrastructure.Reservation.Policy.ComputeResource","tenantId":"vsphere.local"},
+			{"id":"e2f3a4b5-0000-4000-8000-000000000004","name":"Gold","description":"Gold datastores",
+			"reservationPolicyTypeId":"Infrastructure.Reservation.Policy.Storage","tenantId":"vsphere.local"}
+		],
+		"metadata":{"size":20,"totalElements":2,"totalPages":1,"number":1,"offset":0}
+	}`
+
+	mockNetworkProfile = `{
+		"@type":"ExternalNetworkProfile",
+		"id":"3e5f7091-0000-4000-8000-000000000030",
+		"name":"Development",
+		"description":"Development network",
+		"createdDate":"2019-04-01T10:00:00.000Z",
+		"lastModifiedDate":"2019-04-02T10:00:00.000Z",
+		"isHidden":false,
+		"profileType":"EXTERNAL",
+		"IPAMEndpointId":"1",
+		"addressSpaceExternalId":"default",
+		"subnetMask":"255.255.255.0",
+		"gatewayAddress":"10.10.0.1",
+		"primaryDnsAddress":"10.10.0.2",
+		"secondaryDnsAddress":null,
+		"dnsSuffix":"dev.example.com",
+		"dnsSearchSuffix":null,
+		"primaryWinsAddress":null,
+		"secondaryWinsAddress":null,
+		"definedRanges":[
+			{"id":"r-1","name":"servers","description":"","beginIPv4Address":"10.10.0.10","endIPv4Address":"10.10.0.19","state":"UNALLOCATED",
+			"definedAddresses":[
+				{"id":"a-1","IPv4Address":"10.10.0.10","state":"ALLOCATED","virtualMachineName":"dev-web-01"},
+				{"id":"a-2","IPv4Address":"10.10.0.11","state":"UNALLOCATED"},
+				{"id":"a-3","IPv4Address":"10.10.0.12","state":"ALLOCATED","virtualMachineName":"dev-web-02"}
+			]},
+			{"id":"r-2","name":"desktops","description":"VDI","beginIPv4Address":"10.10.0.100","endIPv4Address":"10.10.0.199","state":"UNALLOCATED",
+			"definedAddresses":[]}
+		]
+	}`
+
+	mockPropertyDefinition = `{
+		"id":"VirtualMachine.Network0.Name",
+		"name":"VirtualMachine.Network0.Name",
+		"label":"Network",
+		"description":"Network of the first NIC",
+		"tenantId":"vsphere.local",
+		"dataType":{"type":"primitive","typeId":"STRING"},
+		"displayAdvice":"DROPDOWN",
+		"isMultiValued":false,
+		"orderIndex":2,
+		"facets":{
+			"mandatory":{"type":"constantClause","value":{"type":"boolean","value":true}},
+			"permissibleValues":{"type":"staticClause","value":[
+				{"underlyingValue":{"type":"string","value":"dev-net"},"label":"Development"},
+				{"underlyingValue":{"type":"string","value":"prod-net"},"label":"Production"}
+			]}
+		},
+		"version":1
+	}`
+
+	mockPropertyGroup = `{
+		"id":"WebServers",
+		"name":"WebServers",
+		"label":"Web servers",
+		"description":"Properties of the web servers",
+		"tenantId":"vsphere.local",
+		"properties":{
+			"Custom.Role":{"defaultValue":{"type":"string","value":"web"},"encrypted":false,"visible":true},
+			"Custom.Password":{"defaultValue":{"type":"secureString","value":"********"},"encrypted":true,"visible":false}
+		}
+	}`
+
+	mockIntegerPropertyDefinition = `{
+		"id":"Custom.CPU",
+		"name":"Custom.CPU",
+		"label":"CPUs",
+		"tenantId":"vsphere.local",
+		"dataType":{"type":"primitive","typeId":"INTEGER"},
+		"displayAdvice":"SLIDER",
+		"isMultiValued":false,
+		"facets":{
+			"minValue":{"type":"constantClause","value":{"type":"integer","value":1}},
+			"maxValue":{"type":"constantClause","value":{"type":"integer","value":8}}
+		}
+	}`
+
+	mockApprovalPolicy = `{
+		"id":"7a8b9c0d-0000-4000-8000-000000000050",
+		"name":"Production approval",
+		"description":"Approval of the production catalog items",
+		"tenantId":"vsphere.local",
+		"policyTypeId":"com.vmware.cafe.catalog.request.pre",
+		"state":"ACTIVE",
+		"phases":[
+			{"id":"p-1","name":"Pre Approval","phaseTypeId":"com.vmware.cafe.catalog.request.pre",
+			"levels":[
+				{"id":"l-2","name":"Security","levelNumber":2,"approvalMode":"ALL","approverType":"SPECIFIC_USERS_GROUPS",
+				"approvers":[{"tenantName":"vsphere.local","ref":"security@example.com","type":"GROUP"}],
+				"alwaysRequired":false,
+				"condition":{"type":"or","subClauses":[
+					{"type":"expression","operator":"greaterThan","leftOperand":{"type":"path","path":"~cpu"},
+					"rightOperand":{"type":"constant","value":{"type":"string","value":"4"}}},
+					{"type":"expression","operator":"equals","leftOperand":{"type":"path","path":"~environment"},
+					"rightOperand":{"type":"constant","value":{"type":"string","value":"production"}}}
+				]}},
+				{"id":"l-1","name":"Manager","levelNumber":1,"approvalMode":"ANY","approverType":"SPECIFIC_USERS_GROUPS",
+				"approvers":[{"tenantName":"vsphere.local","ref":"jane@example.com","type":"USER"},
+					{"tenantName":"vsphere.local","ref":"john@example.com","type":"USER"}],
+				"alwaysRequired":true}
+			]},
+			{"id":"p-2","name":"Post Approval","phaseTypeId":"com.vmware.cafe.catalog.request.post","levels":[]}
+		],
+		"version":3
+	}`
 )
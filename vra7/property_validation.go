@@ -0,0 +1,148 @@
+package vra7
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// error constants
+const (
+	PropertyRequiredError        = "%s: the property %s requires a value"
+	PropertyTypeError            = "%s: %s is not a valid %s value of the property %s"
+	PropertyValueNotAllowedError = "%s: %s is not an allowed value of the property %s, the allowed values are: %s"
+	PropertyBelowMinimumError    = "%s: %s is less than the minimum %s of the property %s"
+	PropertyAboveMaximumError    = "%s: %s is greater than the maximum %s of the property %s"
+	PropertyTooShortError        = "%s: the value is shorter than the minimum length %d of the property %s"
+	PropertyTooLongError         = "%s: the value is longer than the maximum length %d of the property %s"
+)
+
+// propertyDefinitions caches the property definitions by name, so that they are fetched once per run of the
+// provider and not once per planned deployment. A property without a definition is not cached, its
+// definition may be created during the apply.
+var propertyDefinitions = struct {
+	sync.Mutex
+	definitions map[string]*sdk.PropertyDefinition
+}{
+	definitions: make(map[string]*sdk.PropertyDefinition),
+}
+
+// getPropertyDefinitions returns the property definitions of the properties that have one. A definition that
+// could not be fetched is not validated.
+func getPropertyDefinitions(vraClient *sdk.APIClient, names []string) map[string]*sdk.PropertyDefinition {
+	propertyDefinitions.Lock()
+	defer propertyDefinitions.Unlock()
+	definitions := make(map[string]*sdk.PropertyDefinition)
+	for _, name := range names {
+		definition, ok := propertyDefinitions.definitions[name]
+		if !ok {
+			var err error
+			definition, err = vraClient.GetPropertyDefinition(name)
+			if err != nil {
+				if !sdk.IsNotFoundError(err) {
+					log.Warning("The property definition of %s could not be fetched, the values of the property are not validated: %v", name, err)
+				}
+				continue
+			}
+			propertyDefinitions.definitions[name] = definition
+		}
+		definitions[name] = definition
+	}
+	return definitions
+}
+
+// configurationPropertyNames returns the names of the properties of the configurations, and of the
+// properties of their components that are left without a value
+func configurationPropertyNames(resourceConfigurations []sdk.ResourceConfigurationStruct, unsetProperties map[string][]string) []string {
+	names := make(map[string]interface{})
+	for _, rc := range resourceConfigurations {
+		for name := range rc.Configuration {
+			names[name] = nil
+		}
+		for _, name := range unsetProperties[rc.ComponentName] {
+			names[name] = nil
+		}
+	}
+	return sortedKeys(names)
+}
+
+// validatePropertyValues returns an error message for each value of the configurations that its property
+// definition does not allow, and for each property of their components left without a value that its
+// property definition requires
+func validatePropertyValues(resourceConfigurations []sdk.ResourceConfigurationStruct, unsetProperties map[string][]string,
+	definitions map[string]*sdk.PropertyDefinition) []string {
+	var errs []string
+	for _, rc := range resourceConfigurations {
+		for _, name := range sortedKeys(rc.Configuration) {
+			definition, ok := definitions[name]
+			if !ok {
+				continue
+			}
+			path := fmt.Sprintf("resource_configuration[%s].configuration.%s", rc.ComponentName, name)
+			errs = append(errs, validatePropertyValue(path, convToString(rc.Configuration[name]), definition)...)
+		}
+		for _, name := range unsetProperties[rc.ComponentName] {
+			definition, ok := definitions[name]
+			if !ok || !definition.IsMandatory() {
+				continue
+			}
+			path := fmt.Sprintf("resource_configuration[%s].configuration.%s", rc.ComponentName, name)
+			errs = append(errs, fmt.Sprintf(PropertyRequiredError, path, name))
+		}
+	}
+	return errs
+}
+
+// validatePropertyValue returns an error message for the required flag, the data type, the static list, the
+// bounds and the length of the property definition that the value does not match
+func validatePropertyValue(path, value string, definition *sdk.PropertyDefinition) []string {
+	if value == "" {
+		if definition.IsMandatory() {
+			return []string{fmt.Sprintf(PropertyRequiredError, path, definition.Name)}
+		}
+		return nil
+	}
+	// the value of a multi-valued property is a list, whose values are checked by vRA
+	if definition.IsMultiValued {
+		return nil
+	}
+	dataType := definition.DataType.TypeID
+	if _, err := parseDataTypeValue(dataType, value); err != nil {
+		return []string{fmt.Sprintf(PropertyTypeError, path, value, dataType, definition.Name)}
+	}
+
+	var errs []string
+	if values := definition.StaticValues(); len(values) > 0 {
+		allowed := make([]string, 0, len(values))
+		found := false
+		for _, v := range values {
+			allowedValue := v.UnderlyingValue.String()
+			allowed = append(allowed, allowedValue)
+			found = found || propertyValuesEqual(value, allowedValue)
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf(PropertyValueNotAllowedError, path, value, definition.Name, strings.Join(allowed, ", ")))
+		}
+	}
+	if dataType == sdk.PropertyDataTypeInteger || dataType == sdk.PropertyDataTypeDecimal {
+		number, _ := strconv.ParseFloat(value, 64)
+		if min := definition.Constant(sdk.MinValueFacet); min != nil && number < min.Float() {
+			errs = append(errs, fmt.Sprintf(PropertyBelowMinimumError, path, value, min.String(), definition.Name))
+		}
+		if max := definition.Constant(sdk.MaxValueFacet); max != nil && number > max.Float() {
+			errs = append(errs, fmt.Sprintf(PropertyAboveMaximumError, path, value, max.String(), definition.Name))
+		}
+	}
+	if dataType == sdk.PropertyDataTypeString || dataType == sdk.PropertyDataTypeSecureString {
+		if minLength := definition.Constant(sdk.MinLengthFacet); minLength != nil && len(value) < minLength.Int() {
+			errs = append(errs, fmt.Sprintf(PropertyTooShortError, path, minLength.Int(), definition.Name))
+		}
+		if maxLength := definition.Constant(sdk.MaxLengthFacet); maxLength != nil && len(value) > maxLength.Int() {
+			errs = append(errs, fmt.Sprintf(PropertyTooLongError, path, maxLength.Int(), definition.Name))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,87 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestValidatePropertyValue(t *testing.T) {
+	var network, cpu sdk.PropertyDefinition
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockPropertyDefinition), &network))
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockIntegerPropertyDefinition), &cpu))
+
+	utils.AssertEqualsInt(t, 0, len(validatePropertyValue("network", "prod-net", &network)))
+	errs := validatePropertyValue("network", "test-net", &network)
+	utils.AssertEqualsInt(t, 1, len(errs))
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyValueNotAllowedError, "network", "test-net", network.Name, "dev-net, prod-net"), errs[0])
+	errs = validatePropertyValue("network", "", &network)
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyRequiredError, "network", network.Name), errs[0])
+
+	utils.AssertEqualsInt(t, 0, len(validatePropertyValue("cpu", "", &cpu)))
+	utils.AssertEqualsInt(t, 0, len(validatePropertyValue("cpu", "8", &cpu)))
+	errs = validatePropertyValue("cpu", "two", &cpu)
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyTypeError, "cpu", "two", sdk.PropertyDataTypeInteger, cpu.Name), errs[0])
+	errs = validatePropertyValue("cpu", "0", &cpu)
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyBelowMinimumError, "cpu", "0", "1", cpu.Name), errs[0])
+	errs = validatePropertyValue("cpu", "16", &cpu)
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyAboveMaximumError, "cpu", "16", "8", cpu.Name), errs[0])
+
+	hostname := sdk.NewPropertyDefinition("Custom.Hostname", sdk.PropertyDataTypeString)
+	hostname.SetConstant(sdk.MaxLengthFacet, sdk.NewIntegerValue(5))
+	errs = validatePropertyValue("hostname", "web-server", hostname)
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyTooLongError, "hostname", 5, hostname.Name), errs[0])
+}
+
+func TestValidatePropertyValues(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	networkURL := client.BuildEncodedURL(fmt.Sprintf(sdk.PropertyDefinitionAPI, "VirtualMachine.Network0.Name"), nil)
+	cpuURL := client.BuildEncodedURL(fmt.Sprintf(sdk.PropertyDefinitionAPI, "cpu"), nil)
+	httpmock.RegisterResponder("GET", networkURL, httpmock.NewStringResponder(200, mockPropertyDefinition))
+	httpmock.RegisterResponder("GET", cpuURL, httpmock.NewStringResponder(404, `{"errors":[]}`))
+
+	resourceConfigurations := []sdk.ResourceConfigurationStruct{
+		{ComponentName: "web", Configuration: map[string]interface{}{"cpu": "2", "VirtualMachine.Network0.Name": "test-net"}},
+		{ComponentName: "db", Configuration: map[string]interface{}{"VirtualMachine.Network0.Name": "prod-net"}},
+		{ComponentName: "app", Configuration: map[string]interface{}{}},
+	}
+	// the mandatory network of app is left empty in the request template
+	template := &catalogItemTemplate{Template: &sdk.CatalogItemRequestTemplate{Data: map[string]interface{}{
+		"app": map[string]interface{}{"data": map[string]interface{}{
+			"VirtualMachine.Network0.Name": "",
+			"cpu":                          "",
+			"description":                  "app server",
+			"_cluster":                     1,
+		}},
+		"db": map[string]interface{}{"data": map[string]interface{}{"VirtualMachine.Network0.Name": ""}},
+	}}}
+	unsetProperties := template.unsetProperties(resourceConfigurations)
+	utils.AssertEqualsInt(t, 1, len(unsetProperties))
+	utils.AssertEqualsInt(t, 2, len(unsetProperties["app"]))
+	names := configurationPropertyNames(resourceConfigurations, unsetProperties)
+	utils.AssertEqualsInt(t, 2, len(names))
+
+	definitions := getPropertyDefinitions(&client, names)
+	utils.AssertEqualsInt(t, 1, len(definitions))
+	errs := validatePropertyValues(resourceConfigurations, unsetProperties, definitions)
+	utils.AssertEqualsInt(t, 2, len(errs))
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyValueNotAllowedError, "resource_configuration[web].configuration.VirtualMachine.Network0.Name",
+		"test-net", "VirtualMachine.Network0.Name", "dev-net, prod-net"), errs[0])
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyRequiredError, "resource_configuration[app].configuration.VirtualMachine.Network0.Name",
+		"VirtualMachine.Network0.Name"), errs[1])
+
+	// the definitions are fetched once, the properties without a definition on each validation
+	getPropertyDefinitions(&client, names)
+	calls := httpmock.GetCallCountInfo()
+	utils.AssertEqualsInt(t, 1, calls["GET "+networkURL])
+	utils.AssertEqualsInt(t, 2, calls["GET "+cpuURL])
+}
@@ -0,0 +1,95 @@
+package vra7
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+// The configuration and properties maps of the state only hold strings. A property value read from vRA
+// is formatted by convToString, numbers with all their decimals, and lists and objects as JSON.
+// A configured value is parsed by parsePropertyValue to the type of the value in the template.
+
+// convToString returns the string of a property value read from vRA
+func convToString(value interface{}) string {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Map, reflect.Slice:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	return ""
+}
+
+// parsePropertyValue returns the value of a configured property to send to vRA, of the type of the current
+// value of the property in the template. A value that does not parse to that type is sent as it is configured,
+// a value whose type is unknown, because it is null or not in the template, is parsed if it is JSON.
+func parsePropertyValue(field string, value interface{}, current interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	switch reflect.ValueOf(current).Kind() {
+	case reflect.String:
+		return s
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+		return s
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+		return s
+	}
+	return utils.UnmarshalJSONStringIfNecessary(field, s)
+}
+
+// propertyValuesEqual returns true if the two strings are the same property value, for e.g. "1024" and "1024.0",
+// "true" and "True", or two JSON documents that only differ by their formatting
+func propertyValuesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if fa, err := strconv.ParseFloat(a, 64); err == nil {
+		fb, err := strconv.ParseFloat(b, 64)
+		return err == nil && fa == fb
+	}
+	if ba, err := strconv.ParseBool(a); err == nil {
+		bb, err := strconv.ParseBool(b)
+		return err == nil && ba == bb
+	}
+	var ja, jb interface{}
+	if json.Unmarshal([]byte(a), &ja) != nil || json.Unmarshal([]byte(b), &jb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(ja, jb)
+}
+
+// configuredPropertyValue returns the configured value if it is the same property value as the one read from vRA,
+// so that a value that vRA normalizes does not show a difference
+func configuredPropertyValue(configured interface{}, read interface{}) string {
+	readValue := convToString(read)
+	if configuredValue, ok := configured.(string); ok && propertyValuesEqual(configuredValue, readValue) {
+		return configuredValue
+	}
+	return readValue
+}
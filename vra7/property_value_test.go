@@ -0,0 +1,47 @@
+package vra7
+
+import (
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestConvToString(t *testing.T) {
+	utils.AssertEqualsString(t, "0.5", convToString(0.5))
+	utils.AssertEqualsString(t, "1024", convToString(float64(1024)))
+	utils.AssertEqualsString(t, "0.25", convToString(float32(0.25)))
+	utils.AssertEqualsString(t, "8", convToString(int32(8)))
+	utils.AssertEqualsString(t, "8", convToString(int64(8)))
+	utils.AssertEqualsString(t, "true", convToString(true))
+	utils.AssertEqualsString(t, "", convToString(nil))
+	utils.AssertEqualsString(t, `["dev_sg","prod_sg"]`, convToString([]interface{}{"dev_sg", "prod_sg"}))
+	utils.AssertEqualsString(t, `{"size":8}`, convToString(map[string]interface{}{"size": 8}))
+}
+
+func TestParsePropertyValue(t *testing.T) {
+	// typed after the current value in the template
+	utils.AssertEqualsString(t, "1024", parsePropertyValue("name", "1024", "machine").(string))
+	utils.AssertTrue(t, "number", parsePropertyValue("storage", "0.5", float64(8)) == 0.5)
+	utils.AssertTrue(t, "bool", parsePropertyValue("display_location", "true", false) == true)
+	utils.AssertEqualsString(t, "large", parsePropertyValue("memory", "large", float64(1024)).(string))
+	utils.AssertEqualsInt(t, 2, len(parsePropertyValue("security_tags", `["dev_sg", "prod_sg"]`, []interface{}{}).([]interface{})))
+
+	// without a current value, JSON values are parsed
+	utils.AssertTrue(t, "number", parsePropertyValue("custom", "1024", nil) == float64(1024))
+	utils.AssertEqualsString(t, "value", parsePropertyValue("custom", "value", nil).(string))
+
+	// values that are not strings are sent as they are
+	utils.AssertEqualsInt(t, 2, parsePropertyValue("_cluster", 2, float64(1)).(int))
+}
+
+func TestPropertyValuesEqual(t *testing.T) {
+	utils.AssertTrue(t, "same number", propertyValuesEqual("1024", "1024.0"))
+	utils.AssertTrue(t, "same bool", propertyValuesEqual("True", "true"))
+	utils.AssertTrue(t, "same JSON", propertyValuesEqual(`["dev_sg", "prod_sg"]`, `["dev_sg","prod_sg"]`))
+	utils.AssertFalse(t, "different number", propertyValuesEqual("0.5", "0"))
+	utils.AssertFalse(t, "number and string", propertyValuesEqual("1024", "large"))
+	utils.AssertFalse(t, "different strings", propertyValuesEqual("dev", "prod"))
+
+	utils.AssertEqualsString(t, "1024.0", configuredPropertyValue("1024.0", float64(1024)))
+	utils.AssertEqualsString(t, "2048", configuredPropertyValue("1024", float64(2048)))
+}
@@ -15,10 +15,25 @@ func Provider() terraform.ResourceProvider {
 		Schema:        providerSchema(),
 		ConfigureFunc: providerConfig,
 		ResourcesMap: map[string]*schema.Resource{
-			"vra7_deployment": resourceVra7Deployment(),
+			"vra7_deployment":                 resourceVra7Deployment(),
+			"vra7_approval_policy":            resourceVra7ApprovalPolicy(),
+			"vra7_catalog_service":            resourceVra7CatalogService(),
+			"vra7_composite_blueprint":        resourceVra7CompositeBlueprint(),
+			"vra7_content_package":            resourceVra7ContentPackage(),
+			"vra7_entitlement":                resourceVra7Entitlement(),
+			"vra7_network_profile":            resourceVra7NetworkProfile(),
+			"vra7_property_definition":        resourceVra7PropertyDefinition(),
+			"vra7_property_group":             resourceVra7PropertyGroup(),
+			"vra7_reservation":                resourceVra7Reservation(),
+			"vra7_reservation_policy":         resourceVra7ReservationPolicy(),
+			"vra7_storage_reservation_policy": resourceVra7StorageReservationPolicy(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"vra7_deployment": dataSourceVra7Deployment(),
+			"vra7_approval_policy":            dataSourceVra7ApprovalPolicy(),
+			"vra7_content_package":            dataSourceVra7ContentPackage(),
+			"vra7_deployment":                 dataSourceVra7Deployment(),
+			"vra7_reservation_policy":         dataSourceVra7ReservationPolicy(),
+			"vra7_storage_reservation_policy": dataSourceVra7StorageReservationPolicy(),
 		},
 	}
 }
@@ -7,8 +7,12 @@ import (
 	"github.com/vmware/terraform-provider-vra7/sdk"
 	"github.com/vmware/terraform-provider-vra7/utils"
 	"gopkg.in/jarcoal/httpmock.v1"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -57,6 +61,40 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// TestProviderDocumentedResources checks that the resources and data sources of the documentation
+// pages and of the sidebar are registered in the provider
+func TestProviderDocumentedResources(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	registered := map[string]map[string]*schema.Resource{
+		"r": provider.ResourcesMap,
+		"d": provider.DataSourcesMap,
+	}
+	documented := make(map[string]string)
+	for kind := range registered {
+		pages, err := filepath.Glob(filepath.Join("..", "website", "docs", kind, "*.html.markdown"))
+		utils.AssertNilError(t, err)
+		for _, page := range pages {
+			documented[kind+"/"+strings.TrimSuffix(filepath.Base(page), ".html.markdown")] = page
+		}
+	}
+	sidebar, err := ioutil.ReadFile(filepath.Join("..", "website", "vra7.erb"))
+	utils.AssertNilError(t, err)
+	for _, link := range regexp.MustCompile(`/docs/providers/vra7/([rd]/\w+)\.html`).FindAllStringSubmatch(string(sidebar), -1) {
+		documented[link[1]] = "vra7.erb"
+	}
+
+	utils.AssertTrue(t, "documented resources found", len(documented) > 0)
+	for page, source := range documented {
+		kind, name := page[:1], page[2:]
+		if !strings.HasPrefix(name, "vra7_") {
+			name = "vra7_" + name
+		}
+		if _, ok := registered[kind][name]; !ok {
+			t.Errorf("%s documented in %s is not registered in the provider", name, source)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 
 	if v := os.Getenv("VRA7_HOST"); v == "" {
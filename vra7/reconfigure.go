@@ -0,0 +1,411 @@
+package vra7
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// error constants
+const (
+	DiskShrinkError              = "The disk %s of the component %s cannot be shrunk from %d GB to %d GB"
+	ScheduledTimeMissingError    = "reconfigure_options.scheduled_time is required when execution is %s"
+	ScheduledTimeInvalidError    = "reconfigure_options.scheduled_time %q is not a valid RFC 3339 timestamp: %v"
+	ScheduledTimeForbiddenError  = "reconfigure_options.scheduled_time can only be set when execution is %s"
+	ScheduledTimePastError       = "reconfigure_options.scheduled_time %s is in the past, the Reconfigure actions would not run in a maintenance window"
+	ScheduledRequestPendingError = "The Reconfigure request %s of the component %s is scheduled and has not run yet, the component cannot be reconfigured again before it runs"
+	MountPointInvalidError       = "%s must be a drive letter, for e.g., E, or an absolute path, for e.g., /data, got %s"
+)
+
+// reconfigure execution modes
+const (
+	ExecutionImmediate = "immediate"
+	ExecutionScheduled = "scheduled"
+)
+
+// ReconfigureOptions represents the reconfigure_options block of the deployment
+type ReconfigureOptions struct {
+	Execution       string
+	ScheduledTime   string
+	AllowPowerCycle bool
+}
+
+func expandReconfigureOptions(options []interface{}) *ReconfigureOptions {
+	if len(options) == 0 || options[0] == nil {
+		return nil
+	}
+	optionsMap := options[0].(map[string]interface{})
+	return &ReconfigureOptions{
+		Execution:       optionsMap["execution"].(string),
+		ScheduledTime:   optionsMap["scheduled_time"].(string),
+		AllowPowerCycle: optionsMap["allow_power_cycle"].(bool),
+	}
+}
+
+// validateReconfigureExecution checks that the execution of the reconfigure_options is supported
+func validateReconfigureExecution(v interface{}, k string) ([]string, []error) {
+	execution := v.(string)
+	if execution != ExecutionImmediate && execution != ExecutionScheduled {
+		return nil, []error{fmt.Errorf("%s must be one of %s or %s, got %s", k, ExecutionImmediate, ExecutionScheduled, execution)}
+	}
+	return nil, nil
+}
+
+// validateMountPoint checks that the mount point of a disk is a drive letter of a Windows machine or an absolute
+// path of a Linux machine. vRA passes it to the guest agent as the drive letter of the new disk, which the agent
+// of a Linux machine uses as the mount path.
+func validateMountPoint(v interface{}, k string) ([]string, []error) {
+	mountPoint := v.(string)
+	letter := strings.ToUpper(strings.TrimSuffix(mountPoint, ":"))
+	if len(letter) == 1 && letter[0] >= 'A' && letter[0] <= 'Z' {
+		return nil, nil
+	}
+	if path.IsAbs(mountPoint) && path.Clean(mountPoint) == mountPoint {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf(MountPointInvalidError, k, mountPoint)}
+}
+
+// validate checks that the scheduled time is provided, and only provided, for a scheduled execution
+func (o *ReconfigureOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.Execution == ExecutionScheduled {
+		if o.ScheduledTime == "" {
+			return fmt.Errorf(ScheduledTimeMissingError, ExecutionScheduled)
+		}
+		if _, err := time.Parse(time.RFC3339, o.ScheduledTime); err != nil {
+			return fmt.Errorf(ScheduledTimeInvalidError, o.ScheduledTime, err)
+		}
+	} else if o.ScheduledTime != "" {
+		return fmt.Errorf(ScheduledTimeForbiddenError, ExecutionScheduled)
+	}
+	return nil
+}
+
+// checkScheduledTime returns an error if the maintenance window of a scheduled execution is over
+func (o *ReconfigureOptions) checkScheduledTime(now time.Time) error {
+	if !o.isScheduled() {
+		return nil
+	}
+	scheduledTime, err := time.Parse(time.RFC3339, o.ScheduledTime)
+	if err == nil && scheduledTime.Before(now) {
+		return fmt.Errorf(ScheduledTimePastError, o.ScheduledTime)
+	}
+	return nil
+}
+
+// isScheduled returns true if the reconfigure requests are run in a maintenance window
+func (o *ReconfigureOptions) isScheduled() bool {
+	return o != nil && o.Execution == ExecutionScheduled
+}
+
+// applyToActionTemplate sets the execution and power options in the reconfigure action template data
+func (o *ReconfigureOptions) applyToActionTemplate(templateData map[string]interface{}) {
+	if o == nil {
+		return
+	}
+	if o.isScheduled() {
+		templateData[sdk.ReconfigureExecutionSelector] = sdk.ReconfigureExecutionScheduled
+		templateData[sdk.ReconfigureScheduleDate] = o.ScheduledTime
+	} else {
+		templateData[sdk.ReconfigureExecutionSelector] = sdk.ReconfigureExecutionImmediate
+		delete(templateData, sdk.ReconfigureScheduleDate)
+	}
+	if o.AllowPowerCycle {
+		templateData[sdk.ReconfigurePowerActionSelector] = sdk.ReconfigurePowerActionReboot
+	} else {
+		templateData[sdk.ReconfigurePowerActionSelector] = sdk.ReconfigurePowerActionNoReboot
+	}
+	templateData[sdk.ReconfigureAllowForceShutdown] = strconv.FormatBool(o.AllowPowerCycle)
+}
+
+// readScheduledRequests returns the scheduled Reconfigure requests, by the id of the machine they reconfigure,
+// that have not run yet. Until a request runs, the disks and network adapters of its machine are not refreshed
+// from vRA, so that plan does not show the changes that are already scheduled.
+func readScheduledRequests(vraClient *sdk.APIClient, scheduledRequests map[string]interface{}) map[string]string {
+	pending := make(map[string]string)
+	for resourceID, id := range scheduledRequests {
+		requestID := id.(string)
+		status, err := vraClient.GetRequestStatus(requestID)
+		if err != nil {
+			log.Warning("The status of the scheduled Reconfigure request %s could not be read: %v", requestID, err)
+			pending[resourceID] = requestID
+			continue
+		}
+		switch status.Phase {
+		case sdk.Successful:
+		case sdk.Failed, sdk.Rejected:
+			log.Warning("The scheduled Reconfigure request %s of the machine %s is %s.", requestID, resourceID, status.Phase)
+		default:
+			pending[resourceID] = requestID
+		}
+	}
+	return pending
+}
+
+// diskName returns the name used to refer to the disk at index in the error messages
+func diskName(disk sdk.DiskConfiguration, index int) string {
+	if disk.Label != "" {
+		return fmt.Sprintf("%q", disk.Label)
+	}
+	return fmt.Sprintf("at position %d", index)
+}
+
+// findInstanceDisk returns the position of the disk of the instance corresponding to the disk block at index,
+// or -1 if there is none. Disk blocks with a label are matched by label, the others by their position
+func findInstanceDisk(disks []sdk.Disk, disk sdk.DiskConfiguration, index int) int {
+	if disk.Label != "" {
+		for i, d := range disks {
+			if d.Label == disk.Label {
+				return i
+			}
+		}
+		return -1
+	}
+	if index < len(disks) {
+		return index
+	}
+	return -1
+}
+
+// refreshDiskConfigurations updates the size of the disk blocks with the capacity of the disks
+// of the instance. Disk blocks that do not exist on the instance are dropped, so that they are added again
+func refreshDiskConfigurations(disks []sdk.DiskConfiguration, instance sdk.Instance) []sdk.DiskConfiguration {
+	if disks == nil {
+		return nil
+	}
+	refreshed := make([]sdk.DiskConfiguration, 0, len(disks))
+	for index, disk := range disks {
+		i := findInstanceDisk(instance.Disks, disk, index)
+		if i == -1 {
+			continue
+		}
+		disk.Size = instance.Disks[i].Capacity
+		refreshed = append(refreshed, disk)
+	}
+	return refreshed
+}
+
+// checkDiskShrink returns an error if a disk block is smaller than the corresponding disk of any of the instances
+func checkDiskShrink(componentName string, disks []sdk.DiskConfiguration, instances []sdk.Instance) error {
+	for _, instance := range instances {
+		for index, disk := range disks {
+			i := findInstanceDisk(instance.Disks, disk, index)
+			if i != -1 && disk.Size < instance.Disks[i].Capacity {
+				return fmt.Errorf(DiskShrinkError, diskName(disk, index), componentName, instance.Disks[i].Capacity, disk.Size)
+			}
+		}
+	}
+	return nil
+}
+
+// updateDisksInActionTemplate adds or resizes the disks in the disk section of the reconfigure
+// action template data so that they match the disk blocks. Returns true if the template has changed
+func updateDisksInActionTemplate(templateData map[string]interface{}, componentName string, disks []sdk.DiskConfiguration) (bool, error) {
+	changed := false
+	templateDisks, _ := templateData[sdk.ReconfigureDisks].([]interface{})
+
+	// the data maps of the disks in the template, in the same order as on the machine
+	diskDataList := make([]map[string]interface{}, 0, len(templateDisks))
+	instanceDisks := make([]sdk.Disk, 0, len(templateDisks))
+	for _, templateDisk := range templateDisks {
+		diskMap, _ := templateDisk.(map[string]interface{})
+		diskData, _ := diskMap["data"].(map[string]interface{})
+		if diskData == nil {
+			diskData = make(map[string]interface{})
+			if diskMap != nil {
+				diskMap["data"] = diskData
+			}
+		}
+		diskDataList = append(diskDataList, diskData)
+		instanceDisks = append(instanceDisks, sdk.Disk{
+			Capacity: convToInt(diskData["size"]),
+			Label:    convToString(diskData["label"]),
+		})
+	}
+
+	for index, disk := range disks {
+		i := findInstanceDisk(instanceDisks, disk, index)
+		if i == -1 {
+			log.Info("Adding the disk %s of %d GB to the component %s", diskName(disk, index), disk.Size, componentName)
+			templateDisks = append(templateDisks, newActionTemplateDisk(disk))
+			changed = true
+			continue
+		}
+		capacity := instanceDisks[i].Capacity
+		if disk.Size < capacity {
+			return false, fmt.Errorf(DiskShrinkError, diskName(disk, index), componentName, capacity, disk.Size)
+		}
+		if disk.Size > capacity {
+			log.Info("Resizing the disk %s of the component %s from %d GB to %d GB", diskName(disk, index), componentName, capacity, disk.Size)
+			diskDataList[i]["size"] = disk.Size
+			changed = true
+		}
+	}
+	templateData[sdk.ReconfigureDisks] = templateDisks
+	return changed, nil
+}
+
+// newActionTemplateDisk returns the entry of a new disk for the disk section of the reconfigure action template
+func newActionTemplateDisk(disk sdk.DiskConfiguration) map[string]interface{} {
+	diskData := map[string]interface{}{
+		"size":              disk.Size,
+		"label":             nil,
+		"driveLetter":       nil,
+		"reservationPolicy": nil,
+		"storagePath":       nil,
+		"customProperties":  []interface{}{},
+	}
+	if disk.Label != "" {
+		diskData["label"] = disk.Label
+	}
+	if disk.MountPoint != "" {
+		diskData["driveLetter"] = disk.MountPoint
+		if !strings.HasPrefix(disk.MountPoint, "/") {
+			diskData["driveLetter"] = strings.ToUpper(strings.TrimSuffix(disk.MountPoint, ":"))
+		}
+	}
+	return map[string]interface{}{
+		"componentTypeId": sdk.IaaSProxyProvider,
+		"componentId":     nil,
+		"classId":         sdk.MachineDiskClassID,
+		"typeFilter":      nil,
+		"data":            diskData,
+	}
+}
+
+// networkAdapterPositions returns, for every network_adapter block, the position of the
+// network adapter on the machine. The blocks are ordered by order, then by their position in the config
+func networkAdapterPositions(adapters []sdk.NetworkAdapterConfiguration) []int {
+	indexes := make([]int, len(adapters))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return adapters[indexes[i]].Order < adapters[indexes[j]].Order
+	})
+	positions := make([]int, len(adapters))
+	for position, index := range indexes {
+		positions[index] = position
+	}
+	return positions
+}
+
+// refreshNetworkAdapterConfigurations updates the network_adapter blocks with the network adapters of the instance.
+// Blocks without a network adapter on the instance are dropped, so that they are added again, and the
+// network adapters of the instance that are not declared are added, so that they are removed
+func refreshNetworkAdapterConfigurations(adapters []sdk.NetworkAdapterConfiguration, instance sdk.Instance) []sdk.NetworkAdapterConfiguration {
+	if adapters == nil {
+		return nil
+	}
+	positions := networkAdapterPositions(adapters)
+	refreshed := make([]sdk.NetworkAdapterConfiguration, 0, len(instance.Networks))
+	maxOrder := 0
+	for index, adapter := range adapters {
+		if adapter.Order > maxOrder {
+			maxOrder = adapter.Order
+		}
+		position := positions[index]
+		if position >= len(instance.Networks) {
+			continue
+		}
+		network := instance.Networks[position]
+		adapter.NetworkName = network.NetworkName
+		if network.NetworkProfile != "" {
+			adapter.NetworkProfile = network.NetworkProfile
+		}
+		// addresses assigned by the network profile are only tracked when they are declared
+		if adapter.Address != "" {
+			adapter.Address = network.IPv4Address
+		}
+		refreshed = append(refreshed, adapter)
+	}
+	for position := len(adapters); position < len(instance.Networks); position++ {
+		maxOrder++
+		refreshed = append(refreshed, sdk.NetworkAdapterConfiguration{
+			NetworkName:    instance.Networks[position].NetworkName,
+			NetworkProfile: instance.Networks[position].NetworkProfile,
+			Order:          maxOrder,
+		})
+	}
+	return refreshed
+}
+
+// updateNetworkAdaptersInActionTemplate adds, updates or removes the network adapters in the network section of the
+// reconfigure action template data so that they match the network_adapter blocks. Returns true if the template has changed
+func updateNetworkAdaptersInActionTemplate(templateData map[string]interface{}, componentName string, adapters []sdk.NetworkAdapterConfiguration) bool {
+	changed := false
+	templateNics, _ := templateData[sdk.ReconfigureNics].([]interface{})
+
+	sorted := make([]sdk.NetworkAdapterConfiguration, len(adapters))
+	for index, position := range networkAdapterPositions(adapters) {
+		sorted[position] = adapters[index]
+	}
+
+	nics := make([]interface{}, 0, len(sorted))
+	for position, adapter := range sorted {
+		if position >= len(templateNics) {
+			log.Info("Adding a network adapter on the network %s to the component %s", adapter.NetworkName, componentName)
+			nics = append(nics, newActionTemplateNic(adapter))
+			changed = true
+			continue
+		}
+		nic, _ := templateNics[position].(map[string]interface{})
+		nicData, _ := nic["data"].(map[string]interface{})
+		if nicData == nil {
+			nics = append(nics, newActionTemplateNic(adapter))
+			changed = true
+			continue
+		}
+		if convToString(nicData["name"]) != adapter.NetworkName {
+			log.Info("Moving the network adapter %d of the component %s to the network %s", position, componentName, adapter.NetworkName)
+			nicData["name"] = adapter.NetworkName
+			changed = true
+		}
+		if adapter.NetworkProfile != "" && convToString(nicData["networkProfile"]) != adapter.NetworkProfile {
+			nicData["networkProfile"] = adapter.NetworkProfile
+			changed = true
+		}
+		if adapter.Address != "" && convToString(nicData["address"]) != adapter.Address {
+			nicData["address"] = adapter.Address
+			changed = true
+		}
+		nics = append(nics, nic)
+	}
+	if len(templateNics) > len(sorted) {
+		log.Info("Removing %d network adapter(s) from the component %s", len(templateNics)-len(sorted), componentName)
+		changed = true
+	}
+	templateData[sdk.ReconfigureNics] = nics
+	return changed
+}
+
+// newActionTemplateNic returns the entry of a new network adapter for the network section of the reconfigure action template
+func newActionTemplateNic(adapter sdk.NetworkAdapterConfiguration) map[string]interface{} {
+	nicData := map[string]interface{}{
+		"name":           adapter.NetworkName,
+		"networkProfile": nil,
+		"address":        nil,
+		"macAddress":     nil,
+	}
+	if adapter.NetworkProfile != "" {
+		nicData["networkProfile"] = adapter.NetworkProfile
+	}
+	if adapter.Address != "" {
+		nicData["address"] = adapter.Address
+	}
+	return map[string]interface{}{
+		"componentTypeId": sdk.IaaSProxyProvider,
+		"componentId":     nil,
+		"classId":         sdk.MachineNicClassID,
+		"typeFilter":      nil,
+		"data":            nicData,
+	}
+}
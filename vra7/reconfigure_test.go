@@ -0,0 +1,193 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func mockReconfigureTemplateData() map[string]interface{} {
+	templateData := make(map[string]interface{})
+	_ = utils.UnmarshalJSON([]byte(mockReconfigureActionTemplateData), &templateData)
+	return templateData
+}
+
+func TestUpdateDisksInActionTemplate(t *testing.T) {
+	templateData := mockReconfigureTemplateData()
+
+	// the disks match the machine, nothing to reconfigure
+	changed, err := updateDisksInActionTemplate(templateData, "vSphere1", []sdk.DiskConfiguration{
+		{Size: 8, Label: "Hard disk 1"},
+	})
+	utils.AssertNilError(t, err)
+	utils.AssertFalse(t, "disks changed", changed)
+
+	// resize the first disk and add a second one
+	changed, err = updateDisksInActionTemplate(templateData, "vSphere1", []sdk.DiskConfiguration{
+		{Size: 16, Label: "Hard disk 1"},
+		{Size: 20, Label: "data", MountPoint: "/data"},
+	})
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "disks changed", changed)
+	disks := templateData[sdk.ReconfigureDisks].([]interface{})
+	utils.AssertEqualsInt(t, 2, len(disks))
+	firstDisk := disks[0].(map[string]interface{})["data"].(map[string]interface{})
+	utils.AssertEqualsInt(t, 16, convToInt(firstDisk["size"]))
+	utils.AssertEqualsString(t, "6000C29d-a1aa-db33-800f-6c1a1c725057", firstDisk["externalId"].(string))
+	newDisk := disks[1].(map[string]interface{})
+	utils.AssertEqualsString(t, sdk.MachineDiskClassID, newDisk["classId"].(string))
+	utils.AssertEqualsString(t, "/data", newDisk["data"].(map[string]interface{})["driveLetter"].(string))
+
+	// shrinking a disk is rejected
+	templateData = mockReconfigureTemplateData()
+	_, err = updateDisksInActionTemplate(templateData, "vSphere1", []sdk.DiskConfiguration{
+		{Size: 4},
+	})
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(DiskShrinkError, "at position 0", "vSphere1", 8, 4), err.Error())
+}
+
+func TestValidateMountPoint(t *testing.T) {
+	for _, mountPoint := range []string{"E", "e", "E:", "/data", "/var/lib/mysql"} {
+		_, errs := validateMountPoint(mountPoint, "mount_point")
+		utils.AssertEqualsInt(t, 0, len(errs))
+	}
+	for _, mountPoint := range []string{"", "EF", "data", "/data/", "E:\\data"} {
+		_, errs := validateMountPoint(mountPoint, "mount_point")
+		utils.AssertEqualsInt(t, 1, len(errs))
+	}
+
+	// a drive letter is sent as the letter alone
+	newDisk := newActionTemplateDisk(sdk.DiskConfiguration{Size: 20, MountPoint: "e:"})
+	utils.AssertEqualsString(t, "E", newDisk["data"].(map[string]interface{})["driveLetter"].(string))
+}
+
+func TestCheckDiskShrink(t *testing.T) {
+	instances := []sdk.Instance{
+		{Disks: []sdk.Disk{{Capacity: 8, Label: "Hard disk 1"}, {Capacity: 2}}},
+	}
+	utils.AssertNilError(t, checkDiskShrink("vSphere1", []sdk.DiskConfiguration{{Size: 8}, {Size: 4}}, instances))
+	utils.AssertNilError(t, checkDiskShrink("vSphere1", []sdk.DiskConfiguration{{Size: 10, Label: "Hard disk 1"}, {Size: 1, Label: "new"}}, instances))
+	utils.AssertNotNilError(t, checkDiskShrink("vSphere1", []sdk.DiskConfiguration{{Size: 8}, {Size: 1}}, instances))
+
+	refreshed := refreshDiskConfigurations([]sdk.DiskConfiguration{{Size: 10, Label: "Hard disk 1"}, {Size: 1, Label: "new"}}, instances[0])
+	utils.AssertEqualsInt(t, 1, len(refreshed))
+	utils.AssertEqualsInt(t, 8, refreshed[0].Size)
+}
+
+func TestUpdateNetworkAdaptersInActionTemplate(t *testing.T) {
+	templateData := mockReconfigureTemplateData()
+
+	// the network adapters match the machine, nothing to reconfigure
+	changed := updateNetworkAdaptersInActionTemplate(templateData, "vSphere1", []sdk.NetworkAdapterConfiguration{
+		{NetworkName: "dvPortGroup-wdc-sdm-vm-1521"},
+	})
+	utils.AssertFalse(t, "network adapters changed", changed)
+
+	// add a second network adapter declared before the first one in the config
+	changed = updateNetworkAdaptersInActionTemplate(templateData, "vSphere1", []sdk.NetworkAdapterConfiguration{
+		{NetworkName: "backup", Address: "192.168.1.10", Order: 1},
+		{NetworkName: "dvPortGroup-wdc-sdm-vm-1521", Order: 0},
+	})
+	utils.AssertTrue(t, "network adapters changed", changed)
+	nics := templateData[sdk.ReconfigureNics].([]interface{})
+	utils.AssertEqualsInt(t, 2, len(nics))
+	firstNic := nics[0].(map[string]interface{})["data"].(map[string]interface{})
+	utils.AssertEqualsString(t, "00:50:56:b6:8a:02", firstNic["macAddress"].(string))
+	secondNic := nics[1].(map[string]interface{})
+	utils.AssertEqualsString(t, sdk.MachineNicClassID, secondNic["classId"].(string))
+	utils.AssertEqualsString(t, "192.168.1.10", secondNic["data"].(map[string]interface{})["address"].(string))
+
+	// remove the second network adapter
+	changed = updateNetworkAdaptersInActionTemplate(templateData, "vSphere1", []sdk.NetworkAdapterConfiguration{
+		{NetworkName: "dvPortGroup-wdc-sdm-vm-1521"},
+	})
+	utils.AssertTrue(t, "network adapters changed", changed)
+	utils.AssertEqualsInt(t, 1, len(templateData[sdk.ReconfigureNics].([]interface{})))
+}
+
+func TestRefreshNetworkAdapterConfigurations(t *testing.T) {
+	instance := sdk.Instance{
+		Networks: []sdk.Network{
+			{NetworkName: "frontend", IPv4Address: "10.0.0.5"},
+			{NetworkName: "backend", IPv4Address: "10.0.1.5"},
+		},
+	}
+	refreshed := refreshNetworkAdapterConfigurations([]sdk.NetworkAdapterConfiguration{
+		{NetworkName: "backend", Address: "10.0.1.6", Order: 1},
+	}, instance)
+	utils.AssertEqualsInt(t, 2, len(refreshed))
+	// the only block is the first network adapter of the machine
+	utils.AssertEqualsString(t, "frontend", refreshed[0].NetworkName)
+	utils.AssertEqualsString(t, "10.0.0.5", refreshed[0].Address)
+	utils.AssertEqualsString(t, "backend", refreshed[1].NetworkName)
+	utils.AssertEqualsString(t, "", refreshed[1].Address)
+	utils.AssertEqualsInt(t, 2, refreshed[1].Order)
+
+	utils.AssertNil(t, refreshNetworkAdapterConfigurations(nil, instance))
+}
+
+func TestReconfigureOptions(t *testing.T) {
+	utils.AssertNil(t, expandReconfigureOptions(make([]interface{}, 0)))
+	utils.AssertNilError(t, expandReconfigureOptions(make([]interface{}, 0)).validate())
+
+	options := expandReconfigureOptions([]interface{}{
+		map[string]interface{}{
+			"execution":         ExecutionScheduled,
+			"scheduled_time":    "",
+			"allow_power_cycle": false,
+		},
+	})
+	err := options.validate()
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(ScheduledTimeMissingError, ExecutionScheduled), err.Error())
+
+	options.ScheduledTime = "next sunday"
+	utils.AssertNotNilError(t, options.validate())
+
+	options.ScheduledTime = "2026-11-01T02:00:00Z"
+	utils.AssertNilError(t, options.validate())
+
+	// a maintenance window that is over is rejected
+	now, _ := time.Parse(time.RFC3339, "2026-10-19T12:00:00Z")
+	utils.AssertNilError(t, options.checkScheduledTime(now))
+	err = options.checkScheduledTime(now.AddDate(0, 1, 0))
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(ScheduledTimePastError, "2026-11-01T02:00:00Z"), err.Error())
+
+	templateData := mockReconfigureTemplateData()
+	options.applyToActionTemplate(templateData)
+	utils.AssertEqualsString(t, sdk.ReconfigureExecutionScheduled, templateData[sdk.ReconfigureExecutionSelector].(string))
+	utils.AssertEqualsString(t, "2026-11-01T02:00:00Z", templateData[sdk.ReconfigureScheduleDate].(string))
+	utils.AssertEqualsString(t, sdk.ReconfigurePowerActionNoReboot, templateData[sdk.ReconfigurePowerActionSelector].(string))
+	utils.AssertEqualsString(t, "false", templateData[sdk.ReconfigureAllowForceShutdown].(string))
+
+	options = &ReconfigureOptions{Execution: ExecutionImmediate, ScheduledTime: "2026-11-01T02:00:00Z"}
+	utils.AssertNotNilError(t, options.validate())
+}
+
+func TestReadScheduledRequests(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+	for requestID, phase := range map[string]string{"request-1": sdk.Submitted, "request-2": sdk.Successful, "request-3": sdk.Failed} {
+		httpmock.RegisterResponder("GET", client.BuildEncodedURL(sdk.ConsumerRequests+"/"+requestID, nil),
+			httpmock.NewStringResponder(200, fmt.Sprintf(`{"phase": "%s"}`, phase)))
+	}
+
+	// only the requests that have not run yet are kept
+	pending := readScheduledRequests(&client, map[string]interface{}{
+		"machine-1": "request-1",
+		"machine-2": "request-2",
+		"machine-3": "request-3",
+	})
+	utils.AssertEqualsInt(t, 1, len(pending))
+	utils.AssertEqualsString(t, "request-1", pending["machine-1"])
+}
@@ -0,0 +1,117 @@
+package vra7
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// RedactedValue replaces the secured values in the request_payload
+const RedactedValue = "<redacted>"
+
+// planRequestPayload sets the request_payload planned for a new deployment to the catalog item request that create
+// will post. It is left computed while the configuration depends on values that are only known after apply.
+// The optional and computed arguments that are not known yet are the ones not set in the config.
+func planRequestPayload(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("resource_configuration") || !d.NewValueKnown("deployment_configuration") || !d.NewValueKnown("terraform_marker") ||
+		(d.Get("catalog_item_name").(string) == "" && d.Get("catalog_item_id").(string) == "") {
+		return d.SetNewComputed("request_payload")
+	}
+	vraClient := meta.(*sdk.APIClient)
+	p, err := readProviderConfiguration(d, vraClient)
+	if err != nil {
+		return err
+	}
+	// the component names were validated against the same request template by checkConfigurationAgainstTemplate
+	t, err := getCatalogItemTemplate(vraClient, p.CatalogItemID)
+	if err != nil {
+		return err
+	}
+	requestTemplate, err := copyRequestTemplate(t.Template)
+	if err != nil {
+		return err
+	}
+	if err := p.mergeIntoRequestTemplate(requestTemplate); err != nil {
+		return err
+	}
+	payload, err := redactRequestPayload(requestTemplate, t.Schema)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("request_payload", payload)
+}
+
+// setRequestPayload saves the catalog item request posted by create in the state
+func setRequestPayload(d *schema.ResourceData, vraClient *sdk.APIClient, requestTemplate *sdk.CatalogItemRequestTemplate) error {
+	var requestSchema *sdk.CatalogItemRequestSchema
+	if t, err := getCatalogItemTemplate(vraClient, requestTemplate.CatalogItemID); err == nil {
+		requestSchema = t.Schema
+	}
+	payload, err := redactRequestPayload(requestTemplate, requestSchema)
+	if err != nil {
+		return err
+	}
+	return d.Set("request_payload", payload)
+}
+
+// copyRequestTemplate returns a deep copy of the request template, so that the cached template is not modified
+func copyRequestTemplate(requestTemplate *sdk.CatalogItemRequestTemplate) (*sdk.CatalogItemRequestTemplate, error) {
+	data, err := json.Marshal(requestTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var requestTemplateCopy sdk.CatalogItemRequestTemplate
+	if err := json.Unmarshal(data, &requestTemplateCopy); err != nil {
+		return nil, err
+	}
+	return &requestTemplateCopy, nil
+}
+
+// redactRequestPayload returns the JSON of the request with the secured values redacted
+func redactRequestPayload(requestTemplate *sdk.CatalogItemRequestTemplate, requestSchema *sdk.CatalogItemRequestSchema) (string, error) {
+	payload, err := copyRequestTemplate(requestTemplate)
+	if err != nil {
+		return "", err
+	}
+	redactTemplateData(payload.Data, requestSchema)
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// redactTemplateData redacts the secured values in the data of the request or of a component,
+// and in the data of the components nested in it
+func redactTemplateData(data map[string]interface{}, requestSchema *sdk.CatalogItemRequestSchema) {
+	for key, value := range data {
+		field := requestSchema.GetField(key)
+		if component, ok := value.(map[string]interface{}); ok {
+			if componentData, ok := component["data"].(map[string]interface{}); ok {
+				var componentSchema *sdk.CatalogItemRequestSchema
+				if field != nil {
+					componentSchema = field.DataType.Schema
+				}
+				redactTemplateData(componentData, componentSchema)
+				continue
+			}
+		}
+		if isSecuredValue(key, value, field) {
+			data[key] = RedactedValue
+		}
+	}
+}
+
+// isSecuredValue returns true if the value is a secure string in the request schema, or if the property
+// name looks like a password when the schema is not available
+func isSecuredValue(propertyName string, value interface{}, field *sdk.RequestSchemaField) bool {
+	if value == nil {
+		return false
+	}
+	if field != nil && field.DataType.TypeID == sdk.SecureStringType {
+		return true
+	}
+	return strings.Contains(strings.ToLower(propertyName), "password")
+}
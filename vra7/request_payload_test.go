@@ -0,0 +1,50 @@
+package vra7
+
+import (
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestRedactRequestPayload(t *testing.T) {
+	var requestTemplate sdk.CatalogItemRequestTemplate
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockRequestTemplate), &requestTemplate))
+	var requestSchema sdk.CatalogItemRequestSchema
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockRequestSchema), &requestSchema))
+	// the deployment level api_token field is a secure string
+	apiTokenField := sdk.RequestSchemaField{ID: "api_token"}
+	apiTokenField.DataType.TypeID = sdk.SecureStringType
+	requestSchema.Fields = append(requestSchema.Fields, apiTokenField)
+
+	p := &ProviderSchema{
+		Description: "payload preview",
+		Lease:       10,
+		DeploymentConfiguration: map[string]interface{}{
+			"api_token": "s3cr3t",
+		},
+		ResourceConfiguration: []sdk.ResourceConfigurationStruct{
+			{
+				ComponentName: "mock.test.machine1",
+				Cluster:       2,
+				Configuration: map[string]interface{}{"cpu": "4", "admin_password": "VMware1!"},
+			},
+		},
+	}
+	p.mergeIntoRequestTemplate(&requestTemplate)
+
+	payload, err := redactRequestPayload(&requestTemplate, &requestSchema)
+	utils.AssertNilError(t, err)
+	var redacted sdk.CatalogItemRequestTemplate
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(payload), &redacted))
+	utils.AssertEqualsString(t, "payload preview", redacted.Description)
+	utils.AssertEqualsInt(t, 10, convToInt(redacted.Data["_leaseDays"]))
+	utils.AssertEqualsString(t, RedactedValue, redacted.Data["api_token"].(string))
+	componentData := redacted.Data["mock.test.machine1"].(map[string]interface{})["data"].(map[string]interface{})
+	utils.AssertEqualsInt(t, 4, convToInt(componentData["cpu"]))
+	utils.AssertEqualsInt(t, 2, convToInt(componentData["_cluster"]))
+	utils.AssertEqualsString(t, RedactedValue, componentData["admin_password"].(string))
+
+	// the request that is posted keeps the secured values
+	utils.AssertEqualsString(t, "s3cr3t", requestTemplate.Data["api_token"].(string))
+}
@@ -1,6 +1,7 @@
 package vra7
 
 import (
+	"fmt"
 	"reflect"
 	"strconv"
 
@@ -32,6 +33,8 @@ func resourceConfigurationSchema() *schema.Schema {
 					Optional: true,
 					Default:  1,
 				},
+				"disk":            diskConfigurationSchema(),
+				"network_adapter": networkAdapterConfigurationSchema(),
 				"parent_resource_id": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -46,6 +49,57 @@ func resourceConfigurationSchema() *schema.Schema {
 	}
 }
 
+func diskConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"size": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"mount_point": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateMountPoint,
+				},
+			},
+		},
+	}
+}
+
+func networkAdapterConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"network_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"network_profile": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"order": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
 func dataResourceConfigurationSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -114,6 +168,74 @@ func instancesSchema() *schema.Schema {
 						Type: schema.TypeString,
 					},
 				},
+				"connection_host": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"disks":    disksSchema(),
+				"networks": networksSchema(),
+			},
+		},
+	}
+}
+
+func disksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"datastore": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"storage_reservation_policy": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func networksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"network_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_profile": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ipv4_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ipv6_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"mac_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"gateway": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 			},
 		},
 	}
@@ -127,12 +249,15 @@ func expandResourceConfiguration(rConfigurations []interface{}) []sdk.ResourceCo
 		for _, i := range configMap["instances"].([]interface{}) {
 			ins := i.(map[string]interface{})
 			instance := sdk.Instance{
-				ResourceID:   ins["resource_id"].(string),
-				Name:         ins["name"].(string),
-				IPAddress:    ins["ip_address"].(string),
-				ResourceType: ins["resource_type"].(string),
-				Description:  ins["description"].(string),
-				Properties:   ins["properties"].(map[string]interface{}),
+				ResourceID:     ins["resource_id"].(string),
+				Name:           ins["name"].(string),
+				IPAddress:      ins["ip_address"].(string),
+				ResourceType:   ins["resource_type"].(string),
+				Description:    ins["description"].(string),
+				Properties:     ins["properties"].(map[string]interface{}),
+				ConnectionHost: ins["connection_host"].(string),
+				Disks:          expandDisks(ins["disks"].([]interface{})),
+				Networks:       expandNetworks(ins["networks"].([]interface{})),
 			}
 			instances = append(instances, instance)
 		}
@@ -144,6 +269,12 @@ func expandResourceConfiguration(rConfigurations []interface{}) []sdk.ResourceCo
 			RequestID:        configMap["request_id"].(string),
 			Instances:        instances,
 		}
+		if disks, ok := configMap["disk"]; ok {
+			rConfig.Disks = expandDiskConfigurations(disks.([]interface{}))
+		}
+		if adapters, ok := configMap["network_adapter"]; ok {
+			rConfig.NetworkAdapters = expandNetworkAdapterConfigurations(adapters.([]interface{}))
+		}
 		configs = append(configs, rConfig)
 	}
 	return configs
@@ -163,6 +294,9 @@ func flattenResourceConfigurations(resourceConfigList []sdk.ResourceConfiguratio
 			instanceMap["resource_type"] = instance.ResourceType
 			instanceMap["name"] = instance.Name
 			instanceMap["ip_address"] = instance.IPAddress
+			instanceMap["connection_host"] = instance.ConnectionHost
+			instanceMap["disks"] = flattenDisks(instance.Disks)
+			instanceMap["networks"] = flattenNetworks(instance.Networks)
 			propMap, configurationMap := parseDataMap(instance.Properties, config.Configuration)
 			instanceMap["properties"] = propMap
 			instances = append(instances, instanceMap)
@@ -173,6 +307,12 @@ func flattenResourceConfigurations(resourceConfigList []sdk.ResourceConfiguratio
 		helper["request_id"] = config.RequestID
 		helper["parent_resource_id"] = config.ParentResourceID
 		helper["cluster"] = clusterCountMap[config.ComponentName]
+		if config.Disks != nil {
+			helper["disk"] = flattenDiskConfigurations(config.Disks)
+		}
+		if config.NetworkAdapters != nil {
+			helper["network_adapter"] = flattenNetworkAdapterConfigurations(config.NetworkAdapters)
+		}
 
 		rConfigs = append(rConfigs, helper)
 	}
@@ -180,6 +320,227 @@ func flattenResourceConfigurations(resourceConfigList []sdk.ResourceConfiguratio
 	return rConfigs
 }
 
+func expandDiskConfigurations(disks []interface{}) []sdk.DiskConfiguration {
+	expanded := make([]sdk.DiskConfiguration, 0, len(disks))
+	for _, d := range disks {
+		diskMap := d.(map[string]interface{})
+		expanded = append(expanded, sdk.DiskConfiguration{
+			Size:       diskMap["size"].(int),
+			Label:      diskMap["label"].(string),
+			MountPoint: diskMap["mount_point"].(string),
+		})
+	}
+	return expanded
+}
+
+func flattenDiskConfigurations(disks []sdk.DiskConfiguration) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(disks))
+	for _, disk := range disks {
+		flattened = append(flattened, map[string]interface{}{
+			"size":        disk.Size,
+			"label":       disk.Label,
+			"mount_point": disk.MountPoint,
+		})
+	}
+	return flattened
+}
+
+func expandNetworkAdapterConfigurations(adapters []interface{}) []sdk.NetworkAdapterConfiguration {
+	expanded := make([]sdk.NetworkAdapterConfiguration, 0, len(adapters))
+	for _, a := range adapters {
+		adapterMap := a.(map[string]interface{})
+		expanded = append(expanded, sdk.NetworkAdapterConfiguration{
+			NetworkName:    adapterMap["network_name"].(string),
+			NetworkProfile: adapterMap["network_profile"].(string),
+			Address:        adapterMap["address"].(string),
+			Order:          adapterMap["order"].(int),
+		})
+	}
+	return expanded
+}
+
+func flattenNetworkAdapterConfigurations(adapters []sdk.NetworkAdapterConfiguration) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(adapters))
+	for _, adapter := range adapters {
+		flattened = append(flattened, map[string]interface{}{
+			"network_name":    adapter.NetworkName,
+			"network_profile": adapter.NetworkProfile,
+			"address":         adapter.Address,
+			"order":           adapter.Order,
+		})
+	}
+	return flattened
+}
+
+func expandDisks(disks []interface{}) []sdk.Disk {
+	expanded := make([]sdk.Disk, 0, len(disks))
+	for _, d := range disks {
+		diskMap := d.(map[string]interface{})
+		expanded = append(expanded, sdk.Disk{
+			Capacity:                 diskMap["capacity"].(int),
+			Label:                    diskMap["label"].(string),
+			Datastore:                diskMap["datastore"].(string),
+			StorageReservationPolicy: diskMap["storage_reservation_policy"].(string),
+		})
+	}
+	return expanded
+}
+
+func flattenDisks(disks []sdk.Disk) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(disks))
+	for _, disk := range disks {
+		flattened = append(flattened, map[string]interface{}{
+			"capacity":                   disk.Capacity,
+			"label":                      disk.Label,
+			"datastore":                  disk.Datastore,
+			"storage_reservation_policy": disk.StorageReservationPolicy,
+		})
+	}
+	return flattened
+}
+
+func expandNetworks(networks []interface{}) []sdk.Network {
+	expanded := make([]sdk.Network, 0, len(networks))
+	for _, n := range networks {
+		networkMap := n.(map[string]interface{})
+		expanded = append(expanded, sdk.Network{
+			NetworkName:    networkMap["network_name"].(string),
+			NetworkProfile: networkMap["network_profile"].(string),
+			IPv4Address:    networkMap["ipv4_address"].(string),
+			IPv6Address:    networkMap["ipv6_address"].(string),
+			MACAddress:     networkMap["mac_address"].(string),
+			Gateway:        networkMap["gateway"].(string),
+		})
+	}
+	return expanded
+}
+
+func flattenNetworks(networks []sdk.Network) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(networks))
+	for _, network := range networks {
+		flattened = append(flattened, map[string]interface{}{
+			"network_name":    network.NetworkName,
+			"network_profile": network.NetworkProfile,
+			"ipv4_address":    network.IPv4Address,
+			"ipv6_address":    network.IPv6Address,
+			"mac_address":     network.MACAddress,
+			"gateway":         network.Gateway,
+		})
+	}
+	return flattened
+}
+
+// newInstance builds the instance view of a machine component of a deployment
+func newInstance(component sdk.DeploymentComponents) sdk.Instance {
+	data := component.Data
+	instance := sdk.Instance{}
+	instance.IPAddress = convToString(data[sdk.IPAddress])
+	instance.Name = component.Name
+	instance.ResourceID = component.ID
+	instance.ResourceType = component.Type
+	instance.Properties = data
+	instance.Disks = parseDiskVolumes(data)
+	instance.Networks = parseNetworkList(data)
+	instance.ConnectionHost = getConnectionHost(instance)
+	return instance
+}
+
+// parseDiskVolumes reads the DISK_VOLUMES list of the machine resource data. The disk
+// properties that are not part of the list are looked up in the VirtualMachine.DiskN.* custom properties
+func parseDiskVolumes(resourceData map[string]interface{}) []sdk.Disk {
+	disks := make([]sdk.Disk, 0)
+	for index, entry := range getResourceDataList(resourceData, sdk.DiskVolumes) {
+		disk := sdk.Disk{
+			Capacity:                 convToInt(entry[sdk.DiskCapacity]),
+			Label:                    convToString(entry[sdk.DiskLabel]),
+			Datastore:                convToString(entry[sdk.DiskStorage]),
+			StorageReservationPolicy: convToString(resourceData[fmt.Sprintf(sdk.DiskProperty, index, "StorageReservationPolicy")]),
+		}
+		if disk.Label == "" {
+			disk.Label = convToString(resourceData[fmt.Sprintf(sdk.DiskProperty, index, "Label")])
+		}
+		if disk.Datastore == "" {
+			disk.Datastore = convToString(resourceData[fmt.Sprintf(sdk.DiskProperty, index, "Storage")])
+		}
+		if disk.Datastore == "" {
+			disk.Datastore = convToString(resourceData[sdk.StorageName])
+		}
+		disks = append(disks, disk)
+	}
+	return disks
+}
+
+// parseNetworkList reads the NETWORK_LIST list of the machine resource data. The network
+// properties that are not part of the list are looked up in the VirtualMachine.NetworkN.* custom properties
+func parseNetworkList(resourceData map[string]interface{}) []sdk.Network {
+	networks := make([]sdk.Network, 0)
+	for index, entry := range getResourceDataList(resourceData, sdk.NetworkList) {
+		network := sdk.Network{
+			NetworkName:    convToString(entry[sdk.NetworkName]),
+			NetworkProfile: convToString(entry[sdk.NetworkProfile]),
+			IPv4Address:    convToString(entry[sdk.NetworkAddress]),
+			MACAddress:     convToString(entry[sdk.NetworkMACAddress]),
+			IPv6Address:    convToString(resourceData[fmt.Sprintf(sdk.NetworkProperty, index, "IPv6Address")]),
+			Gateway:        convToString(resourceData[fmt.Sprintf(sdk.NetworkProperty, index, "Gateway")]),
+		}
+		if network.NetworkName == "" {
+			network.NetworkName = convToString(resourceData[fmt.Sprintf(sdk.NetworkProperty, index, "Name")])
+		}
+		if network.NetworkProfile == "" {
+			network.NetworkProfile = convToString(resourceData[fmt.Sprintf(sdk.NetworkProperty, index, "NetworkProfileName")])
+		}
+		if network.IPv4Address == "" {
+			network.IPv4Address = convToString(resourceData[fmt.Sprintf(sdk.NetworkProperty, index, "Address")])
+		}
+		if network.MACAddress == "" {
+			network.MACAddress = convToString(resourceData[fmt.Sprintf(sdk.NetworkProperty, index, "MacAddress")])
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// getResourceDataList returns the entries of a list property like DISK_VOLUMES or NETWORK_LIST.
+// The resource view API wraps every entry in a component with a data map while the deployment API
+// returns the entries as plain maps, so both are handled here
+func getResourceDataList(resourceData map[string]interface{}, key string) []map[string]interface{} {
+	entries := make([]map[string]interface{}, 0)
+	list, ok := resourceData[key].([]interface{})
+	if !ok {
+		return entries
+	}
+	for _, item := range list {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if data, ok := entry["data"].(map[string]interface{}); ok {
+			entry = data
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// getConnectionHost returns the address to be used to connect to the instance,
+// for instance by a remote-exec provisioner
+func getConnectionHost(instance sdk.Instance) string {
+	if instance.IPAddress != "" {
+		return instance.IPAddress
+	}
+	for _, network := range instance.Networks {
+		if network.IPv4Address != "" {
+			return network.IPv4Address
+		}
+	}
+	for _, network := range instance.Networks {
+		if network.IPv6Address != "" {
+			return network.IPv6Address
+		}
+	}
+	return convToString(instance.Properties[sdk.MachineName])
+}
+
 func parseDataMap(resourceData map[string]interface{}, configurationMap map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
 	stateMap := make(map[string]interface{})
 	resourcePropertyMapper := ResourceMapper()
@@ -196,8 +557,8 @@ func parseDataMap(resourceData map[string]interface{}, configurationMap map[stri
 			parseMap(key, stateMap, configurationMap, value.(map[string]interface{}))
 		default:
 			stateMap[key] = convToString(value)
-			if _, ok := configurationMap[key]; ok {
-				configurationMap[key] = convToString(value)
+			if configured, ok := configurationMap[key]; ok {
+				configurationMap[key] = configuredPropertyValue(configured, value)
 			}
 		}
 	}
@@ -216,8 +577,8 @@ func parseMap(prefix string, stateMap map[string]interface{}, configurationMap m
 			parseMap(prefix+"."+key, stateMap, configurationMap, value.(map[string]interface{}))
 		default:
 			stateMap[prefix+"."+key] = convToString(value)
-			if _, ok := configurationMap[prefix+"."+key]; ok {
-				configurationMap[key] = convToString(value)
+			if configured, ok := configurationMap[prefix+"."+key]; ok {
+				configurationMap[prefix+"."+key] = configuredPropertyValue(configured, value)
 			}
 		}
 	}
@@ -252,31 +613,22 @@ func parseArray(prefix string, stateMap map[string]interface{}, configurationMap
 			}
 		default:
 			stateMap[prefix+"."+convToString(index)] = convToString(val)
-			if _, ok := configurationMap[prefix+"."+convToString(index)]; ok {
-				configurationMap[prefix+"."+convToString(index)] = convToString(value)
+			if configured, ok := configurationMap[prefix+"."+convToString(index)]; ok {
+				configurationMap[prefix+"."+convToString(index)] = configuredPropertyValue(configured, val)
 			}
 		}
 	}
 }
 
-func convToString(value interface{}) string {
-
-	v := reflect.ValueOf(value)
-	switch v.Kind() {
-	case reflect.String:
-		return value.(string)
-	case reflect.Float64:
-		return strconv.FormatFloat(value.(float64), 'f', 0, 64)
-	case reflect.Float32:
-		return strconv.FormatFloat(value.(float64), 'f', 0, 32)
-	case reflect.Int:
-		return strconv.Itoa(value.(int))
-	case reflect.Int32:
-		return strconv.Itoa(value.(int))
-	case reflect.Int64:
-		return strconv.FormatInt(value.(int64), 10)
-	case reflect.Bool:
-		return strconv.FormatBool(value.(bool))
+func convToInt(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
 	}
-	return ""
+	return 0
 }
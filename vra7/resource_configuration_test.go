@@ -0,0 +1,71 @@
+package vra7
+
+import (
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestNewInstance(t *testing.T) {
+	component := sdk.DeploymentComponents{
+		ID:   "9eb27c35-d766-4238-81fd-cb0445680ebe",
+		Name: "Terraform-B0178",
+		Type: sdk.InfrastructureVirtual,
+		Data: map[string]interface{}{
+			"Component":   "vSphere1",
+			"MachineName": "Terraform-B0178",
+			"ip_address":  "10.145.155.53",
+			"DISK_VOLUMES": []interface{}{
+				map[string]interface{}{
+					"DISK_INPUT_ID": "DISK_INPUT_ID1",
+					"DISK_CAPACITY": float64(8),
+					"DISK_LABEL":    "Hard disk 1",
+				},
+				map[string]interface{}{
+					"componentTypeId": "com.vmware.csp.component.iaas.proxy.provider",
+					"classId":         "dynamicops.api.model.DiskInputModel",
+					"data": map[string]interface{}{
+						"DISK_INPUT_ID": "DISK_INPUT_ID2",
+						"DISK_CAPACITY": float64(2),
+					},
+				},
+			},
+			"NETWORK_LIST": []interface{}{
+				map[string]interface{}{
+					"NETWORK_NAME":        "dvPortGroup-wdc-sdm-vm-1521",
+					"NETWORK_MAC_ADDRESS": "00:50:56:b6:a4:05",
+				},
+			},
+			"VirtualMachine.Storage.Name":                           "wdc-eso-ins-a-VMs-1",
+			"VirtualMachine.Disk1.StorageReservationPolicy":         "gold",
+			"VirtualMachine.Network0.Address":                       "10.145.155.53",
+			"VirtualMachine.Network0.Gateway":                       "10.145.155.1",
+			"VirtualMachine.Cafe.Blueprint.Component.Cluster.Index": "0",
+		},
+	}
+
+	instance := newInstance(component)
+	utils.AssertEqualsString(t, "10.145.155.53", instance.ConnectionHost)
+	utils.AssertEqualsInt(t, 2, len(instance.Disks))
+	utils.AssertEqualsInt(t, 8, instance.Disks[0].Capacity)
+	utils.AssertEqualsString(t, "Hard disk 1", instance.Disks[0].Label)
+	utils.AssertEqualsString(t, "wdc-eso-ins-a-VMs-1", instance.Disks[0].Datastore)
+	utils.AssertEqualsInt(t, 2, instance.Disks[1].Capacity)
+	utils.AssertEqualsString(t, "gold", instance.Disks[1].StorageReservationPolicy)
+
+	utils.AssertEqualsInt(t, 1, len(instance.Networks))
+	utils.AssertEqualsString(t, "dvPortGroup-wdc-sdm-vm-1521", instance.Networks[0].NetworkName)
+	utils.AssertEqualsString(t, "00:50:56:b6:a4:05", instance.Networks[0].MACAddress)
+	utils.AssertEqualsString(t, "10.145.155.53", instance.Networks[0].IPv4Address)
+	utils.AssertEqualsString(t, "10.145.155.1", instance.Networks[0].Gateway)
+
+	// without an ip address, the connection host falls back to the network adapters and then the machine name
+	delete(component.Data, "ip_address")
+	instance = newInstance(component)
+	utils.AssertEqualsString(t, "10.145.155.53", instance.ConnectionHost)
+
+	delete(component.Data, "VirtualMachine.Network0.Address")
+	instance = newInstance(component)
+	utils.AssertEqualsString(t, "Terraform-B0178", instance.ConnectionHost)
+}
@@ -0,0 +1,350 @@
+package vra7
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// approval policy error constants
+const (
+	ApprovalLevelApproverError   = "level.%d: the level %s has no approvers or approver_groups"
+	ApprovalPolicyNotFoundError  = "No approval policy named %s is found"
+	AmbiguousApprovalPolicyError = "Several approval policies are named %s"
+)
+
+// conditionModeClauses are the clauses that combine the conditions of a level, by condition_mode
+var conditionModeClauses = map[string]string{
+	sdk.ApprovalModeAll: sdk.ConditionClauseAnd,
+	sdk.ApprovalModeAny: sdk.ConditionClauseOr,
+}
+
+func resourceVra7ApprovalPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7ApprovalPolicyCreate,
+		Read:   resourceVra7ApprovalPolicyRead,
+		Update: resourceVra7ApprovalPolicyUpdate,
+		Delete: resourceVra7ApprovalPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceVra7ApprovalPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateStringInSlice([]string{sdk.ApprovalPolicyTypeCatalogRequest, sdk.ApprovalPolicyTypeResourceAction,
+					sdk.ApprovalPolicyTypeReconfigure}),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sdk.ApprovalPolicyStatusDraft,
+				ValidateFunc: validateStringInSlice([]string{sdk.ApprovalPolicyStatusDraft, sdk.ApprovalPolicyStatusActive}),
+			},
+			"level": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"approval_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sdk.ApprovalModeAny,
+							ValidateFunc: validateStringInSlice([]string{sdk.ApprovalModeAny, sdk.ApprovalModeAll}),
+						},
+						"approvers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"approver_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"condition_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sdk.ApprovalModeAll,
+							ValidateFunc: validateStringInSlice([]string{sdk.ApprovalModeAny, sdk.ApprovalModeAll}),
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validateStringInSlice([]string{sdk.ConditionOperatorEquals, sdk.ConditionOperatorNotEquals,
+											sdk.ConditionOperatorLessThan, sdk.ConditionOperatorGreaterThan, sdk.ConditionOperatorContains,
+											sdk.ConditionOperatorStartsWith, sdk.ConditionOperatorEndsWith}),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceVra7ApprovalPolicyCustomizeDiff checks that every level has an approver
+func resourceVra7ApprovalPolicyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("level") {
+		return nil
+	}
+	for i, l := range d.Get("level").([]interface{}) {
+		levelMap := l.(map[string]interface{})
+		if levelMap["approvers"].(*schema.Set).Len() == 0 && levelMap["approver_groups"].(*schema.Set).Len() == 0 {
+			return fmt.Errorf(ApprovalLevelApproverError, i, levelMap["name"].(string))
+		}
+	}
+	return nil
+}
+
+func resourceVra7ApprovalPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_approval_policy %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	policy := &sdk.ApprovalPolicy{PolicyTypeID: d.Get("type").(string)}
+	expandApprovalPolicy(d, vraClient.Tenant, policy)
+	created, err := vraClient.CreateApprovalPolicy(policy)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID)
+	log.Info("Finished creating the resource vra7_approval_policy with id %s", d.Id())
+	return resourceVra7ApprovalPolicyRead(d, meta)
+}
+
+func resourceVra7ApprovalPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_approval_policy with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	policy, err := vraClient.GetApprovalPolicy(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The approval policy %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("type", policy.PolicyTypeID)
+	d.Set("status", policy.State)
+	var levels []sdk.ApprovalLevel
+	if phase := policy.PrePhase(); phase != nil {
+		levels = phase.Levels
+	}
+	if err := d.Set("level", flattenApprovalLevels(levels)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7ApprovalPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_approval_policy with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// the update replaces the current version of the approval policy, the levels that are kept keep their ids
+	policy, err := vraClient.GetApprovalPolicy(d.Id())
+	if err != nil {
+		return err
+	}
+	expandApprovalPolicy(d, vraClient.Tenant, policy)
+	if _, err := vraClient.UpdateApprovalPolicy(policy); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_approval_policy with id %s", d.Id())
+	return resourceVra7ApprovalPolicyRead(d, meta)
+}
+
+func resourceVra7ApprovalPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_approval_policy with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	policy, err := vraClient.GetApprovalPolicy(d.Id())
+	if sdk.IsNotFoundError(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// vRA only deletes the approval policies that are not active
+	if policy.State == sdk.ApprovalPolicyStatusActive {
+		policy.State = sdk.ApprovalPolicyStatusInactive
+		if _, err := vraClient.UpdateApprovalPolicy(policy); err != nil {
+			return err
+		}
+	}
+	if err := vraClient.DeleteApprovalPolicy(d.Id()); err != nil {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_approval_policy")
+	return nil
+}
+
+// expandApprovalPolicy sets the arguments of the resource in the approval policy. The levels are the levels
+// of its pre-approval phase.
+func expandApprovalPolicy(d *schema.ResourceData, tenant string, policy *sdk.ApprovalPolicy) {
+	policy.Name = d.Get("name").(string)
+	policy.Description = d.Get("description").(string)
+	policy.TenantID = tenant
+	policy.State = d.Get("status").(string)
+	phase := policy.PrePhase()
+	if phase == nil {
+		policy.Phases = append(policy.Phases, sdk.ApprovalPhase{PhaseTypeID: policy.PolicyTypeID})
+		phase = &policy.Phases[len(policy.Phases)-1]
+	}
+	phase.Levels = expandApprovalLevels(d.Get("level").([]interface{}), phase.Levels, tenant)
+}
+
+// expandApprovalLevels returns the levels of the level blocks, numbered in their order. A level with the same
+// name as a current level keeps its id.
+func expandApprovalLevels(levels []interface{}, current []sdk.ApprovalLevel, tenant string) []sdk.ApprovalLevel {
+	currentIDs := make(map[string]string, len(current))
+	for _, level := range current {
+		currentIDs[level.Name] = level.ID
+	}
+	expanded := make([]sdk.ApprovalLevel, 0, len(levels))
+	for i, l := range levels {
+		levelMap := l.(map[string]interface{})
+		name := levelMap["name"].(string)
+		approvers := expandApprovers(levelMap["approvers"].(*schema.Set).List(), sdk.PrincipalTypeUser, tenant)
+		approvers = append(approvers, expandApprovers(levelMap["approver_groups"].(*schema.Set).List(), sdk.PrincipalTypeGroup, tenant)...)
+		condition := expandApprovalCondition(levelMap["condition_mode"].(string), levelMap["condition"].([]interface{}))
+		expanded = append(expanded, sdk.ApprovalLevel{
+			ID:             currentIDs[name],
+			Name:           name,
+			Description:    levelMap["description"].(string),
+			LevelNumber:    i + 1,
+			ApprovalMode:   levelMap["approval_mode"].(string),
+			ApproverType:   sdk.ApproverTypeSpecificUsersGroups,
+			Approvers:      approvers,
+			AlwaysRequired: condition == nil,
+			Condition:      condition,
+		})
+	}
+	return expanded
+}
+
+func expandApprovers(principals []interface{}, principalType, tenant string) []sdk.Principal {
+	expanded := make([]sdk.Principal, 0, len(principals))
+	for _, principal := range principals {
+		expanded = append(expanded, sdk.Principal{TenantName: tenant, Ref: principal.(string), Type: principalType})
+	}
+	return expanded
+}
+
+// expandApprovalCondition returns the and, or the or, of the expressions of the conditions, or nil without
+// conditions
+func expandApprovalCondition(conditionMode string, conditions []interface{}) *sdk.ApprovalCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	condition := &sdk.ApprovalCondition{Type: conditionModeClauses[conditionMode]}
+	for _, c := range conditions {
+		conditionMap := c.(map[string]interface{})
+		condition.SubClauses = append(condition.SubClauses, sdk.ApprovalCondition{
+			Type:         sdk.ConditionClauseExpression,
+			Operator:     conditionMap["operator"].(string),
+			LeftOperand:  &sdk.ConditionOperand{Type: sdk.OperandTypePath, Path: conditionMap["field"].(string)},
+			RightOperand: &sdk.ConditionOperand{Type: sdk.OperandTypeConstant, Value: sdk.NewPropertyValue(sdk.PropertyDataTypeString, conditionMap["value"].(string))},
+		})
+	}
+	return condition
+}
+
+func flattenApprovalLevels(levels []sdk.ApprovalLevel) []map[string]interface{} {
+	sorted := make([]sdk.ApprovalLevel, len(levels))
+	copy(sorted, levels)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].LevelNumber < sorted[j].LevelNumber })
+
+	flattened := make([]map[string]interface{}, 0, len(sorted))
+	for _, level := range sorted {
+		approvers, groups := []string{}, []string{}
+		for _, approver := range level.Approvers {
+			if approver.Type == sdk.PrincipalTypeGroup {
+				groups = append(groups, approver.Ref)
+			} else {
+				approvers = append(approvers, approver.Ref)
+			}
+		}
+		conditionMode, conditions := flattenApprovalCondition(level.Condition)
+		flattened = append(flattened, map[string]interface{}{
+			"name":            level.Name,
+			"description":     level.Description,
+			"approval_mode":   level.ApprovalMode,
+			"approvers":       approvers,
+			"approver_groups": groups,
+			"condition_mode":  conditionMode,
+			"condition":       conditions,
+		})
+	}
+	return flattened
+}
+
+// flattenApprovalCondition returns the condition_mode and the condition blocks of the condition. A single
+// expression is a condition whose mode is ALL.
+func flattenApprovalCondition(condition *sdk.ApprovalCondition) (string, []map[string]interface{}) {
+	conditions := []map[string]interface{}{}
+	if condition == nil {
+		return sdk.ApprovalModeAll, conditions
+	}
+	conditionMode := sdk.ApprovalModeAll
+	expressions := []sdk.ApprovalCondition{*condition}
+	if condition.Type != sdk.ConditionClauseExpression {
+		if condition.Type == sdk.ConditionClauseOr {
+			conditionMode = sdk.ApprovalModeAny
+		}
+		expressions = condition.SubClauses
+	}
+	for _, expression := range expressions {
+		if expression.LeftOperand == nil || expression.RightOperand == nil {
+			continue
+		}
+		conditions = append(conditions, map[string]interface{}{
+			"field":    expression.LeftOperand.Path,
+			"operator": expression.Operator,
+			"value":    expression.RightOperand.Value.String(),
+		})
+	}
+	return conditionMode, conditions
+}
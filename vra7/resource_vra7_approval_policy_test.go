@@ -0,0 +1,103 @@
+package vra7
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestExpandApprovalPolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceVra7ApprovalPolicy().Schema, map[string]interface{}{
+		"name": "Production approval",
+		"type": sdk.ApprovalPolicyTypeCatalogRequest,
+		"level": []interface{}{
+			map[string]interface{}{
+				"name":      "Manager",
+				"approvers": []interface{}{"jane@example.com"},
+			},
+			map[string]interface{}{
+				"name":            "Change board",
+				"approval_mode":   sdk.ApprovalModeAll,
+				"approver_groups": []interface{}{"cab@example.com"},
+				"condition_mode":  sdk.ApprovalModeAny,
+				"condition": []interface{}{
+					map[string]interface{}{"field": "~cpu", "operator": sdk.ConditionOperatorGreaterThan, "value": "4"},
+				},
+			},
+		},
+	})
+	var current sdk.ApprovalPolicy
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockApprovalPolicy), &current))
+	expandApprovalPolicy(d, "vsphere.local", &current)
+	utils.AssertEqualsString(t, sdk.ApprovalPolicyStatusDraft, current.State)
+	utils.AssertEqualsInt(t, 2, len(current.Phases))
+
+	levels := current.PrePhase().Levels
+	utils.AssertEqualsInt(t, 2, len(levels))
+	// the level that is kept keeps its id, the new level is numbered after it
+	utils.AssertEqualsString(t, "l-1", levels[0].ID)
+	utils.AssertTrue(t, "always required", levels[0].AlwaysRequired)
+	utils.AssertEqualsString(t, sdk.PrincipalTypeUser, levels[0].Approvers[0].Type)
+	utils.AssertEqualsString(t, "", levels[1].ID)
+	utils.AssertEqualsInt(t, 2, levels[1].LevelNumber)
+	utils.AssertFalse(t, "conditional", levels[1].AlwaysRequired)
+	utils.AssertEqualsString(t, sdk.PrincipalTypeGroup, levels[1].Approvers[0].Type)
+	utils.AssertEqualsString(t, sdk.ConditionClauseOr, levels[1].Condition.Type)
+	utils.AssertEqualsString(t, "~cpu", levels[1].Condition.SubClauses[0].LeftOperand.Path)
+
+	// a new policy gets a pre-approval phase of its type
+	policy := &sdk.ApprovalPolicy{PolicyTypeID: sdk.ApprovalPolicyTypeCatalogRequest}
+	expandApprovalPolicy(d, "vsphere.local", policy)
+	utils.AssertEqualsInt(t, 1, len(policy.Phases))
+	utils.AssertEqualsInt(t, 2, len(policy.PrePhase().Levels))
+}
+
+func TestResourceVra7ApprovalPolicyRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "7a8b9c0d-0000-4000-8000-000000000050"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.ApprovalPolicyAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockApprovalPolicy))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7ApprovalPolicy().Schema, map[string]interface{}{})
+	d.SetId(id)
+	utils.AssertNilError(t, resourceVra7ApprovalPolicyRead(d, &client))
+	utils.AssertEqualsString(t, sdk.ApprovalPolicyStatusActive, d.Get("status").(string))
+	// the levels are in the order of their numbers
+	utils.AssertEqualsString(t, "Manager", d.Get("level.0.name").(string))
+	utils.AssertEqualsInt(t, 2, d.Get("level.0.approvers").(*schema.Set).Len())
+	utils.AssertEqualsInt(t, 0, d.Get("level.0.condition.#").(int))
+	utils.AssertEqualsString(t, "Security", d.Get("level.1.name").(string))
+	utils.AssertEqualsString(t, sdk.ApprovalModeAll, d.Get("level.1.approval_mode").(string))
+	utils.AssertEqualsInt(t, 1, d.Get("level.1.approver_groups").(*schema.Set).Len())
+	utils.AssertEqualsString(t, sdk.ApprovalModeAny, d.Get("level.1.condition_mode").(string))
+	utils.AssertEqualsString(t, "production", d.Get("level.1.condition.1.value").(string))
+
+	// an active policy is deactivated before it is deleted
+	var state string
+	httpmock.RegisterResponder("PUT", url,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			var body map[string]interface{}
+			if err := utils.UnmarshalJSON(data, &body); err != nil {
+				return nil, err
+			}
+			state = body["state"].(string)
+			return httpmock.NewStringResponse(200, mockApprovalPolicy), nil
+		})
+	httpmock.RegisterResponder("DELETE", url, httpmock.NewStringResponder(204, ""))
+	utils.AssertNilError(t, resourceVra7ApprovalPolicyDelete(d, &client))
+	utils.AssertEqualsString(t, sdk.ApprovalPolicyStatusInactive, state)
+	utils.AssertEqualsString(t, "", d.Id())
+}
@@ -0,0 +1,216 @@
+package vra7
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func resourceVra7CatalogService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7CatalogServiceCreate,
+		Read:   resourceVra7CatalogServiceRead,
+		Update: resourceVra7CatalogServiceUpdate,
+		Delete: resourceVra7CatalogServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sdk.ServiceStatusActive,
+				ValidateFunc: validateStringInSlice([]string{sdk.ServiceStatusActive, sdk.ServiceStatusInactive}),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hours": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"catalog_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVra7CatalogServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_catalog_service %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	service := &sdk.Service{}
+	expandCatalogService(d, vraClient.Tenant, service)
+	created, err := vraClient.CreateCatalogService(service)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID)
+
+	for _, catalogItemID := range d.Get("catalog_item_ids").(*schema.Set).List() {
+		if err := vraClient.SetCatalogItemService(catalogItemID.(string), d.Id()); err != nil {
+			return err
+		}
+	}
+	log.Info("Finished creating the resource vra7_catalog_service with id %s", d.Id())
+	return resourceVra7CatalogServiceRead(d, meta)
+}
+
+func resourceVra7CatalogServiceRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_catalog_service with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	service, err := vraClient.GetCatalogService(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The catalog service %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	catalogItems, err := vraClient.GetServiceCatalogItems(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", service.Name)
+	d.Set("description", service.Description)
+	d.Set("status", service.Status)
+	d.Set("owner", "")
+	if service.Owner != nil {
+		d.Set("owner", service.Owner.Ref)
+	}
+	d.Set("support_team", "")
+	if service.SupportTeam != nil {
+		d.Set("support_team", service.SupportTeam.Ref)
+	}
+	if err := d.Set("hours", flattenServiceHours(service.Hours)); err != nil {
+		return err
+	}
+	catalogItemIDs := make([]string, 0, len(catalogItems))
+	for _, catalogItem := range catalogItems {
+		catalogItemIDs = append(catalogItemIDs, catalogItem.ID)
+	}
+	if err := d.Set("catalog_item_ids", catalogItemIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7CatalogServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_catalog_service with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// the update replaces the current version of the catalog service
+	service, err := vraClient.GetCatalogService(d.Id())
+	if err != nil {
+		return err
+	}
+	expandCatalogService(d, vraClient.Tenant, service)
+	if _, err := vraClient.UpdateCatalogService(service); err != nil {
+		return err
+	}
+
+	if d.HasChange("catalog_item_ids") {
+		oldIDs, newIDs := d.GetChange("catalog_item_ids")
+		for _, catalogItemID := range oldIDs.(*schema.Set).Difference(newIDs.(*schema.Set)).List() {
+			if err := vraClient.SetCatalogItemService(catalogItemID.(string), ""); err != nil {
+				return err
+			}
+		}
+		for _, catalogItemID := range newIDs.(*schema.Set).Difference(oldIDs.(*schema.Set)).List() {
+			if err := vraClient.SetCatalogItemService(catalogItemID.(string), d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+	log.Info("Finished updating the resource vra7_catalog_service with id %s", d.Id())
+	return resourceVra7CatalogServiceRead(d, meta)
+}
+
+func resourceVra7CatalogServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_catalog_service with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// vRA only deletes the services without catalog items
+	catalogItems, err := vraClient.GetServiceCatalogItems(d.Id())
+	if err != nil {
+		return err
+	}
+	for _, catalogItem := range catalogItems {
+		if err := vraClient.SetCatalogItemService(catalogItem.ID, ""); err != nil {
+			return err
+		}
+	}
+	err = vraClient.DeleteCatalogService(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_catalog_service")
+	return nil
+}
+
+// expandCatalogService sets the arguments of the resource in the catalog service
+func expandCatalogService(d *schema.ResourceData, tenant string, service *sdk.Service) {
+	service.Name = d.Get("name").(string)
+	service.Description = d.Get("description").(string)
+	service.Status = d.Get("status").(string)
+	service.Organization.TenantRef = tenant
+	service.Owner = nil
+	if owner := d.Get("owner").(string); owner != "" {
+		service.Owner = &sdk.Principal{TenantName: tenant, Ref: owner, Type: sdk.PrincipalTypeUser}
+	}
+	service.SupportTeam = nil
+	if supportTeam := d.Get("support_team").(string); supportTeam != "" {
+		service.SupportTeam = &sdk.Principal{TenantName: tenant, Ref: supportTeam, Type: sdk.PrincipalTypeGroup}
+	}
+	service.Hours = nil
+	if hours := d.Get("hours").([]interface{}); len(hours) > 0 && hours[0] != nil {
+		hoursMap := hours[0].(map[string]interface{})
+		service.Hours = &sdk.ServiceHours{
+			StartTime: hoursMap["start_time"].(string),
+			EndTime:   hoursMap["end_time"].(string),
+		}
+	}
+}
+
+func flattenServiceHours(hours *sdk.ServiceHours) []map[string]interface{} {
+	if hours == nil || (hours.StartTime == "" && hours.EndTime == "") {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{{
+		"start_time": hours.StartTime,
+		"end_time":   hours.EndTime,
+	}}
+}
@@ -0,0 +1,65 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestExpandCatalogService(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceVra7CatalogService().Schema, map[string]interface{}{
+		"name":         "Linux",
+		"support_team": "linux-support@example.com",
+		"hours": []interface{}{
+			map[string]interface{}{"start_time": "08:00", "end_time": "18:00"},
+		},
+	})
+
+	service := &sdk.Service{ID: "a1b2c3d4", Version: 2, Owner: &sdk.Principal{Ref: "jdoe@example.com"}}
+	expandCatalogService(d, "vsphere.local", service)
+	utils.AssertEqualsString(t, "a1b2c3d4", service.ID)
+	utils.AssertEqualsInt(t, 2, service.Version)
+	utils.AssertEqualsString(t, sdk.ServiceStatusActive, service.Status)
+	utils.AssertTrue(t, "owner removed", service.Owner == nil)
+	utils.AssertEqualsString(t, "linux-support@example.com", service.SupportTeam.Ref)
+	utils.AssertEqualsString(t, sdk.PrincipalTypeGroup, service.SupportTeam.Type)
+	utils.AssertEqualsString(t, "vsphere.local", service.SupportTeam.TenantName)
+	utils.AssertEqualsString(t, "08:00", service.Hours.StartTime)
+}
+
+func TestResourceVra7CatalogServiceRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "a1b2c3d4-0000-4000-8000-000000000001"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.ServiceAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockCatalogService))
+	httpmock.RegisterResponder("GET", client.BuildEncodedURL(sdk.CatalogItemsAPI, map[string]string{
+		"$filter": "service/id eq '" + id + "'",
+		"page":    "1"}), httpmock.NewStringResponder(200, mockServiceCatalogItems))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7CatalogService().Schema, map[string]interface{}{})
+	d.SetId(id)
+	utils.AssertNilError(t, resourceVra7CatalogServiceRead(d, &client))
+	utils.AssertEqualsString(t, "Linux", d.Get("name").(string))
+	utils.AssertEqualsString(t, sdk.ServiceStatusInactive, d.Get("status").(string))
+	utils.AssertEqualsString(t, "jdoe@example.com", d.Get("owner").(string))
+	utils.AssertEqualsString(t, "", d.Get("support_team").(string))
+	utils.AssertEqualsString(t, "18:00", d.Get("hours.0.end_time").(string))
+	catalogItemIDs := d.Get("catalog_item_ids").(*schema.Set)
+	utils.AssertEqualsInt(t, 2, catalogItemIDs.Len())
+	utils.AssertTrue(t, "catalog item in the service", catalogItemIDs.Contains("0b4e0bd8-f1b6-4b60-9d4a-5f6f7e3a2c11"))
+
+	// a catalog service deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7CatalogServiceRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
@@ -0,0 +1,199 @@
+package vra7
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func resourceVra7CompositeBlueprint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7CompositeBlueprintCreate,
+		Read:   resourceVra7CompositeBlueprintRead,
+		Update: resourceVra7CompositeBlueprintUpdate,
+		Delete: resourceVra7CompositeBlueprintDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceVra7CompositeBlueprintCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateBlueprintContent,
+				DiffSuppressFunc: suppressEquivalentBlueprintContent,
+			},
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"blueprint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"catalog_item_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVra7CompositeBlueprintCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("publish") {
+		if err := d.SetNew("status", blueprintStatus(d.Get("publish").(bool))); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("catalog_item_id"); err != nil {
+			return err
+		}
+	}
+	if !d.HasChange("content") {
+		return nil
+	}
+	if !d.NewValueKnown("content") {
+		return d.SetNewComputed("blueprint_id")
+	}
+	document, err := parseBlueprintContent(d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+	if err := d.SetNew("name", document["name"]); err != nil {
+		return err
+	}
+	// the id of a blueprint does not change, a blueprint with another id replaces it
+	if d.Id() != "" && document.ID() != d.Id() {
+		if err := d.SetNew("blueprint_id", document.ID()); err != nil {
+			return err
+		}
+		return d.ForceNew("content")
+	}
+	return d.SetNew("blueprint_id", document.ID())
+}
+
+func resourceVra7CompositeBlueprintCreate(d *schema.ResourceData, meta interface{}) error {
+	vraClient := meta.(*sdk.APIClient)
+
+	document, err := parseBlueprintContent(d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+	log.Info("Creating the resource vra7_composite_blueprint %s", document.ID())
+	document["@type"] = sdk.CompositeBlueprintType
+	created, err := vraClient.CreateBlueprintDocument(document)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID())
+
+	if err := setBlueprintStatus(vraClient, d.Id(), d.Get("publish").(bool)); err != nil {
+		return err
+	}
+	log.Info("Finished creating the resource vra7_composite_blueprint with id %s", d.Id())
+	return resourceVra7CompositeBlueprintRead(d, meta)
+}
+
+func resourceVra7CompositeBlueprintRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_composite_blueprint with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	document, err := vraClient.GetBlueprintDocument(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The composite blueprint %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	content, err := blueprintContentDrift(d.Get("content").(string), document)
+	if err != nil {
+		return err
+	}
+
+	catalogItemID := ""
+	if document.Status() == sdk.BlueprintStatusPublished {
+		catalogItem, err := vraClient.GetBlueprintCatalogItem(d.Id())
+		if err != nil {
+			return err
+		}
+		if catalogItem != nil {
+			catalogItemID = catalogItem.ID
+		}
+	}
+
+	d.Set("content", content)
+	d.Set("publish", document.Status() == sdk.BlueprintStatusPublished)
+	d.Set("blueprint_id", document.ID())
+	d.Set("name", document["name"])
+	d.Set("status", document.Status())
+	d.Set("catalog_item_id", catalogItemID)
+	return nil
+}
+
+func resourceVra7CompositeBlueprintUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_composite_blueprint with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	if d.HasChange("content") {
+		document, err := parseBlueprintContent(d.Get("content").(string))
+		if err != nil {
+			return err
+		}
+		document["@type"] = sdk.CompositeBlueprintType
+		if _, err := vraClient.UpdateBlueprintDocument(document); err != nil {
+			return err
+		}
+	}
+	if err := setBlueprintStatus(vraClient, d.Id(), d.Get("publish").(bool)); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_composite_blueprint with id %s", d.Id())
+	return resourceVra7CompositeBlueprintRead(d, meta)
+}
+
+func resourceVra7CompositeBlueprintDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_composite_blueprint with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// a published blueprint is taken back to draft before it is deleted
+	if err := setBlueprintStatus(vraClient, d.Id(), false); err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	err := vraClient.DeleteBlueprint(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_composite_blueprint")
+	return nil
+}
+
+func blueprintStatus(publish bool) string {
+	if publish {
+		return sdk.BlueprintStatusPublished
+	}
+	return sdk.BlueprintStatusDraft
+}
+
+// setBlueprintStatus publishes or unpublishes the blueprint, if its status is not already the expected one.
+// An unpublished blueprint can be in draft or retired.
+func setBlueprintStatus(vraClient *sdk.APIClient, id string, publish bool) error {
+	document, err := vraClient.GetBlueprintDocument(id)
+	if err != nil {
+		return err
+	}
+	if publish == (document.Status() == sdk.BlueprintStatusPublished) {
+		return nil
+	}
+	return vraClient.SetBlueprintStatus(id, blueprintStatus(publish))
+}
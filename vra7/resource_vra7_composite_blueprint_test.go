@@ -0,0 +1,122 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+const mockBlueprintYAML = `
+id: BasicSingleMachine
+name: Basic Single Machine
+description: Basic vSphere Machine
+status: DRAFT
+properties:
+  _leaseDays:
+    default: 1
+    max: 60
+    min: 1
+components:
+  vSphereVM1:
+    type: Infrastructure.CatalogItem.Machine.Virtual.vSphere
+    data:
+      cpu:
+        default: 1
+        max: 4
+        min: 1
+      nics:
+      - id: 0
+        network: ${_resource~DefaultNetworkProfile}
+layout:
+  vSphereVM1: 0,1
+`
+
+func TestParseBlueprintContent(t *testing.T) {
+	document, err := parseBlueprintContent(mockBlueprintYAML)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, "BasicSingleMachine", document.ID())
+	utils.AssertEqualsString(t, "", document.Status())
+	cpu := document["components"].(map[string]interface{})["vSphereVM1"].(map[string]interface{})["data"].(map[string]interface{})["cpu"]
+	utils.AssertTrue(t, "numbers decoded as from JSON", cpu.(map[string]interface{})["max"] == float64(4))
+
+	_, err = parseBlueprintContent("id: [")
+	utils.AssertNotNilError(t, err)
+	_, err = parseBlueprintContent("- id")
+	utils.AssertNotNilError(t, err)
+
+	_, errs := validateBlueprintContent("description: no id", "content")
+	utils.AssertEqualsInt(t, 2, len(errs))
+	_, errs = validateBlueprintContent(mockBlueprintYAML, "content")
+	utils.AssertEqualsInt(t, 0, len(errs))
+}
+
+func TestSuppressEquivalentBlueprintContent(t *testing.T) {
+	json := `{"id": "BasicSingleMachine", "name": "Basic Single Machine", "layout": {"vSphereVM1": "0,1"}, "status": "PUBLISHED"}`
+	yaml := "name: Basic Single Machine\nid: BasicSingleMachine\nlayout:\n  vSphereVM1: 0,1\n"
+	utils.AssertTrue(t, "same blueprint", suppressEquivalentBlueprintContent("content", json, yaml, nil))
+	utils.AssertFalse(t, "other layout", suppressEquivalentBlueprintContent("content", json, yaml+"  vSphereVM2: 1,1\n", nil))
+	utils.AssertFalse(t, "invalid content", suppressEquivalentBlueprintContent("content", json, "id: [", nil))
+}
+
+func TestBlueprintContentDrift(t *testing.T) {
+	document := sdk.BlueprintDocument{}
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockBlueprintDocument), &document))
+
+	// the fields that vRA adds to the configured content are not a drift
+	content, err := blueprintContentDrift(mockBlueprintYAML, document)
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsString(t, mockBlueprintYAML, content)
+
+	document["components"].(map[string]interface{})["vSphereVM1"].(map[string]interface{})["data"].(map[string]interface{})["cpu"] =
+		map[string]interface{}{"default": 2, "max": 4, "min": 1}
+	content, err = blueprintContentDrift(mockBlueprintYAML, document)
+	utils.AssertNilError(t, err)
+	utils.AssertFalse(t, "drift", suppressEquivalentBlueprintContent("content", mockBlueprintYAML, content, nil))
+	drifted, err := parseBlueprintContent(content)
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "memory added by vRA left out", drifted["components"].(map[string]interface{})["vSphereVM1"].(map[string]interface{})["data"].(map[string]interface{})["memory"] == nil)
+
+	// an imported blueprint has the whole document as content
+	content, err = blueprintContentDrift("", document)
+	utils.AssertNilError(t, err)
+	imported, err := parseBlueprintContent(content)
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "memory", imported["components"].(map[string]interface{})["vSphereVM1"].(map[string]interface{})["data"].(map[string]interface{})["memory"] != nil)
+	utils.AssertTrue(t, "managed fields left out", imported["lastUpdated"] == nil)
+}
+
+func TestResourceVra7CompositeBlueprintRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "BasicSingleMachine"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.BlueprintDocumentAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockBlueprintDocument))
+	httpmock.RegisterResponder("GET", client.BuildEncodedURL(sdk.CatalogItemsAPI, map[string]string{
+		"$filter": "providerBinding/bindingId eq '" + client.Tenant + "!::!" + id + "'",
+		"page":    "1"}), httpmock.NewStringResponder(200, `{"content":[{"id":"feaedf73-560c-4612-a573-41667e017691"}],"metadata":{"totalPages":1}}`))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7CompositeBlueprint().Schema, map[string]interface{}{
+		"content": mockBlueprintYAML,
+	})
+	d.SetId(id)
+	utils.AssertNilError(t, resourceVra7CompositeBlueprintRead(d, &client))
+	utils.AssertEqualsString(t, mockBlueprintYAML, d.Get("content").(string))
+	utils.AssertEqualsString(t, "Basic Single Machine", d.Get("name").(string))
+	utils.AssertEqualsString(t, sdk.BlueprintStatusPublished, d.Get("status").(string))
+	utils.AssertTrue(t, "published", d.Get("publish").(bool))
+	utils.AssertEqualsString(t, "feaedf73-560c-4612-a573-41667e017691", d.Get("catalog_item_id").(string))
+
+	// a blueprint deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7CompositeBlueprintRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
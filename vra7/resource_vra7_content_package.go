@@ -0,0 +1,130 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func resourceVra7ContentPackage() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVra7ContentPackageCreate,
+		Read:          resourceVra7ContentPackageRead,
+		Delete:        resourceVra7ContentPackageDelete,
+		CustomizeDiff: resourceVra7ContentPackageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"file_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"file_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_content": contentOperationResultsSchema(),
+		},
+	}
+}
+
+// resourceVra7ContentPackageCustomizeDiff plans a new import when the package zip changed
+func resourceVra7ContentPackageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("file_path") {
+		return d.SetNewComputed("file_sha256")
+	}
+	_, checksum, err := readContentPackage(d.Get("file_path").(string))
+	if err != nil {
+		// the package may be written by another resource during the apply
+		if d.Id() == "" {
+			return d.SetNewComputed("file_sha256")
+		}
+		// the zip of an imported package may be removed, the import is kept
+		log.Warning("The content package %s imported as %s cannot be read, it is not imported again: %v", d.Get("file_path").(string), d.Id(), err)
+		return nil
+	}
+	if checksum == d.Get("file_sha256").(string) {
+		return nil
+	}
+	if err := d.SetNew("file_sha256", checksum); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return d.ForceNew("file_sha256")
+}
+
+func resourceVra7ContentPackageCreate(d *schema.ResourceData, meta interface{}) error {
+	vraClient := meta.(*sdk.APIClient)
+	path := d.Get("file_path").(string)
+	log.Info("Importing the content package %s", path)
+
+	zip, checksum, err := readContentPackage(path)
+	if err != nil {
+		return err
+	}
+	fileName := contentPackageFileName(path)
+
+	validation, err := vraClient.ValidateContentPackage(fileName, zip)
+	if err != nil {
+		return err
+	}
+	if validation.Failed() {
+		return fmt.Errorf(ContentPackageValidationError, path, strings.Join(validation.Errors(), "\n"))
+	}
+	if validation.OperationStatus == sdk.OperationStatusWarning {
+		log.Warning("The validation of the content package %s has warnings: %+v", path, validation.OperationResults)
+	}
+
+	resolutionMode := sdk.ResolutionModeSkip
+	if d.Get("overwrite").(bool) {
+		resolutionMode = sdk.ResolutionModeOverwrite
+	}
+	result, err := vraClient.ImportContentPackage(fileName, zip, resolutionMode)
+	if err != nil {
+		return err
+	}
+	if result.Failed() {
+		return fmt.Errorf(ContentPackageImportError, path, strings.Join(result.Errors(), "\n"))
+	}
+	if result.PackageID == "" {
+		return fmt.Errorf(ContentPackageIDError, path)
+	}
+
+	d.SetId(result.PackageID)
+	d.Set("file_sha256", checksum)
+	if err := d.Set("imported_content", flattenContentOperationResults(result.OperationResults)); err != nil {
+		return err
+	}
+	log.Info("Finished importing the content package %s", path)
+	return nil
+}
+
+// resourceVra7ContentPackageRead checks that the imported package is still in vRA. The zip is not read,
+// and the contents of the package are not either: they can be changed by other packages.
+func resourceVra7ContentPackageRead(d *schema.ResourceData, meta interface{}) error {
+	vraClient := meta.(*sdk.APIClient)
+	_, err := vraClient.GetContentPackage(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The content package %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	return err
+}
+
+// resourceVra7ContentPackageDelete removes the import from the state. The imported contents are kept.
+func resourceVra7ContentPackageDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Removing the import of the content package %s from the state, the imported contents are kept", d.Get("file_path").(string))
+	d.SetId("")
+	return nil
+}
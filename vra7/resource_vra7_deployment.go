@@ -2,7 +2,7 @@ package vra7
 
 import (
 	"fmt"
-	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,6 +16,7 @@ import (
 const (
 	ConfigInvalidError                = "The resource_configuration in the config file has invalid component name(s): %v "
 	DestroyActionTemplateError        = "Error retrieving destroy action template for the deployment %v: %v "
+	DescriptionOnlyUpdateError        = "Updating only description and/or reasons is not supported. You can update them during any supported Day-2 actions"
 	BusinessGroupIDNameNotMatchingErr = "The business group name %s and id %s does not belong to the same business group, provide either name or id"
 	CatalogItemIDNameNotMatchingErr   = "The catalog item name %s and id %s does not belong to the same catalog item, provide either name or id"
 )
@@ -40,6 +41,9 @@ type ProviderSchema struct {
 	Lease                   int
 	DeploymentID            string
 	ResourceConfiguration   []sdk.ResourceConfigurationStruct
+	ReconfigureOptions      *ReconfigureOptions
+	AutoExtendWithinDays    int
+	TerraformMarker         string
 }
 
 func resourceVra7Deployment() *schema.Resource {
@@ -51,6 +55,7 @@ func resourceVra7Deployment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceVra7DeploymentCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"catalog_item_name": {
@@ -97,6 +102,36 @@ func resourceVra7Deployment() *schema.Resource {
 				Default:  "Destroy",
 			},
 			"resource_configuration": resourceConfigurationSchema(),
+			"adopt_existing":         adoptExistingSchema(),
+			"reconfigure_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ExecutionImmediate,
+							ValidateFunc: validateReconfigureExecution,
+						},
+						"scheduled_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"allow_power_cycle": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"scheduled_reconfigure_requests": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"lease_days": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -107,6 +142,39 @@ func resourceVra7Deployment() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"auto_extend_within_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"days_until_expiry": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"recreate_if_expired": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lease_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idempotency_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"terraform_marker": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"terraform_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"wait_timeout": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -129,6 +197,12 @@ func resourceVra7Deployment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"xaas_resources": xaasResourcesSchema(),
+			"request_payload": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -149,6 +223,44 @@ func resourceVra7Deployment() *schema.Resource {
 	}
 }
 
+// This function validates the changes planned on a vRA 7 Deployment, so that the changes that apply
+// would reject are reported by terraform plan.
+func resourceVra7DeploymentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	reconfigureOptions := expandReconfigureOptions(d.Get("reconfigure_options").([]interface{}))
+	if err := reconfigureOptions.validate(); err != nil {
+		return err
+	}
+	// the maintenance window is only checked when the changes can trigger a Reconfigure action
+	if d.Id() != "" && (d.HasChange("resource_configuration") || d.HasChange("reconfigure_options")) {
+		if err := reconfigureOptions.checkScheduledTime(time.Now()); err != nil {
+			return err
+		}
+	}
+	if err := checkLeaseAutoExtension(d, time.Now()); err != nil {
+		return err
+	}
+	if err := checkConfigurationAgainstTemplate(d, meta); err != nil {
+		return err
+	}
+	// nothing to compare against before the deployment exists
+	if d.Id() == "" {
+		if err := planTerraformMarker(d); err != nil {
+			return err
+		}
+		return planRequestPayload(d, meta)
+	}
+	if err := checkRecreateIfExpired(d); err != nil {
+		return err
+	}
+	if err := checkForceNewChanges(d); err != nil {
+		return err
+	}
+	if err := checkConfigurationChanges(d, meta); err != nil {
+		return err
+	}
+	return checkDiskChanges(d)
+}
+
 // Terraform call - terraform apply
 // This function creates a new vRA 7 Deployment using configuration in a user's Terraform file.
 // The Deployment is produced by invoking a catalog item that is specified in the configuration.
@@ -172,36 +284,57 @@ func resourceVra7DeploymentCreate(d *schema.ResourceData, meta interface{}) erro
 		return validityErr
 	}
 
-	requestTemplate.Description = p.Description
-	requestTemplate.Reasons = p.Reasons
-	// if business group is not provided, the default business group in the request template is used
-	if p.BusinessGroupID != "" {
-		requestTemplate.BusinessGroupID = p.BusinessGroupID
-	}
-	if p.Lease != 0 {
-		requestTemplate.Data["_leaseDays"] = p.Lease
-	}
-	for field, value := range p.DeploymentConfiguration {
-		requestTemplate.Data[field] = utils.UnmarshalJSONStringIfNecessary(field, value)
+	// take over the deployment created outside terraform instead of requesting a new one
+	if name := getAdoptExistingName(d); name != "" {
+		businessGroupID := p.BusinessGroupID
+		if businessGroupID == "" {
+			businessGroupID = requestTemplate.BusinessGroupID
+		}
+		existing, err := findDeploymentToAdopt(vraClient, name, p.CatalogItemID, businessGroupID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			log.Info("Adopting the existing deployment %s (%s) requested by %s", name, existing.ID, existing.RequestID)
+			d.SetId(existing.RequestID)
+			return resourceVra7DeploymentRead(d, meta)
+		}
+		log.Info("No deployment named %s can be adopted, a new deployment is requested", name)
 	}
 
-	for _, rConfig := range p.ResourceConfiguration {
-		tempConfigMap := make(map[string]interface{})
-		for index, element := range rConfig.Configuration {
-			tempConfigMap[index] = element
+	if p.TerraformMarker == "" {
+		if p.TerraformMarker, err = newTerraformMarker(d.Get("idempotency_key").(string)); err != nil {
+			return err
 		}
-		if rConfig.Cluster != 0 {
-			tempConfigMap["_cluster"] = rConfig.Cluster
+		d.Set("terraform_marker", p.TerraformMarker)
+	}
+	// adopt the deployment requested by an apply that did not save it in the state, only the marker
+	// of an idempotency_key is the same across the runs
+	if d.Get("idempotency_key").(string) != "" {
+		adoptedRequest, err := findMarkedRequest(vraClient, p.TerraformMarker)
+		if err != nil {
+			log.Warning("The catalog requests carrying the marker %s could not be searched: %v", p.TerraformMarker, err)
 		}
-		for propertyName, propertyValue := range tempConfigMap {
-			requestTemplate.Data[rConfig.ComponentName] = updateRequestTemplate(
-				requestTemplate.Data[rConfig.ComponentName].(map[string]interface{}),
-				propertyName,
-				propertyValue)
+		if adoptedRequest != nil {
+			log.Info("Adopting the catalog request %s carrying the marker %s", adoptedRequest.ID, p.TerraformMarker)
+			if adoptedRequest.Phase != sdk.Successful {
+				if _, err := waitForRequestCompletion(d, meta, adoptedRequest.ID); err != nil {
+					return err
+				}
+			}
+			d.SetId(adoptedRequest.ID)
+			return resourceVra7DeploymentRead(d, meta)
 		}
 	}
 
+	if err := p.mergeIntoRequestTemplate(requestTemplate); err != nil {
+		return err
+	}
+
 	log.Info("The updated catalog item request template  is %v\n", requestTemplate.Data)
+	if err := setRequestPayload(d, vraClient, requestTemplate); err != nil {
+		return err
+	}
 
 	//Fire off a catalog item request to create a deployment.
 	catalogRequest, err := vraClient.RequestCatalogItem(requestTemplate)
@@ -218,13 +351,43 @@ func resourceVra7DeploymentCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceVra7DeploymentRead(d, meta)
 }
 
-func updateRequestTemplate(templateInterface map[string]interface{}, field string, value interface{}) map[string]interface{} {
-	replaced := ReplaceValueInRequestTemplate(templateInterface, field, value)
+// merge the configuration into the request template of the catalog item. The properties of a component
+// are set in the data of the component, at vSphereVM1.data.cpu for the property cpu of vSphereVM1, and
+// never in the data of its child components
+func (p *ProviderSchema) mergeIntoRequestTemplate(requestTemplate *sdk.CatalogItemRequestTemplate) error {
+	requestTemplate.Description = stampDescription(p.Description, p.TerraformMarker)
+	requestTemplate.Reasons = p.Reasons
+	// if business group is not provided, the default business group in the request template is used
+	if p.BusinessGroupID != "" {
+		requestTemplate.BusinessGroupID = p.BusinessGroupID
+	}
+	if p.Lease != 0 {
+		requestTemplate.Data["_leaseDays"] = p.Lease
+	}
+	for field, value := range p.DeploymentConfiguration {
+		requestTemplate.Data[field] = parsePropertyValue(field, value, requestTemplate.Data[field])
+	}
 
-	if !replaced {
-		templateInterface["data"] = AddValueToRequestTemplate(templateInterface["data"].(map[string]interface{}), field, value)
+	for _, rConfig := range p.ResourceConfiguration {
+		tempConfigMap := make(map[string]interface{})
+		for index, element := range rConfig.Configuration {
+			tempConfigMap[index] = element
+		}
+		if rConfig.Cluster != 0 {
+			tempConfigMap["_cluster"] = rConfig.Cluster
+		}
+		componentPath := lookupComponentPath(requestTemplate.Data, rConfig.ComponentName)
+		if componentPath == nil {
+			return fmt.Errorf(ConfigInvalidError, rConfig.ComponentName)
+		}
+		componentData := componentPath.Append("data")
+		for _, propertyName := range sortedKeys(tempConfigMap) {
+			if err := AddValueToRequestTemplate(requestTemplate.Data, componentData, propertyName, tempConfigMap[propertyName]); err != nil {
+				return err
+			}
+		}
 	}
-	return templateInterface
+	return nil
 }
 
 // This function updates the state of a vRA 7 Deployment when changes to a Terraform file are applied.
@@ -234,6 +397,11 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 	log.Info("Updating the resource vra7_deployment with request id %s", d.Id())
 	vraClient := meta.(*sdk.APIClient)
 
+	// the day-2 actions are gone once the lease is over
+	if err := checkDeploymentActive(d); err != nil {
+		return err
+	}
+
 	p, err := readProviderConfiguration(d, vraClient)
 	if err != nil {
 		return err
@@ -244,32 +412,10 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// Change Lease Day 2 operation
-	if d.HasChange("expiry_date") {
-		deploymentResourceActions, err := vraClient.GetResourceActions(p.DeploymentID)
-		if err != nil {
+	if expiryDate, ok := getNewExpiryDate(d, p, time.Now()); ok {
+		if err := changeLease(d, meta, p.DeploymentID, expiryDate); err != nil {
 			return err
 		}
-		deploymentActionsMap := GetActionNameIDMap(deploymentResourceActions)
-		changeLeaseActionID := deploymentActionsMap["Change Lease"]
-		if changeLeaseActionID != "" {
-			resourceActionTemplate, _ := vraClient.GetResourceActionTemplate(p.DeploymentID, changeLeaseActionID)
-			log.Info("Starting Change Lease action on the deployment with id %v. The lease will be extended by %v days.", p.DeploymentID, p.Lease)
-			_ = ReplaceValueInRequestTemplate(
-				resourceActionTemplate.Data, "provider-ExpirationDate", d.Get("expiry_date").(string))
-			resourceActionTemplate.Description = d.Get("description").(string)
-			resourceActionTemplate.Reasons = d.Get("reasons").(string)
-			requestID, err := vraClient.PostResourceAction(p.DeploymentID, changeLeaseActionID, resourceActionTemplate)
-			if err != nil {
-				log.Errorf("The change lease request failed with error: %v ", err)
-				return err
-			}
-			_, err = waitForRequestCompletion(d, meta, requestID)
-			if err != nil {
-				log.Errorf("The change lease request failed with error: %v ", err)
-				return err
-			}
-			log.Info("Successfully completed the Change Lease action for the deployment with id %v.", p.DeploymentID)
-		}
 	}
 
 	// get the old and new resource_configuration data
@@ -298,13 +444,12 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 						}
 						resourceActionTemplate.Description = d.Get("description").(string)
 						resourceActionTemplate.Reasons = d.Get("reasons").(string)
-						// get the map from the action template corresponding to the key which is the component name
-						actionTemplateDataMap := GetActionTemplateDataByComponent(resourceActionTemplate.Data, newResourceConfig.ComponentName)
 						// update the template with the new cluster size
 						log.Info("Starting Scale Out action on the deployment with id %v for the component %v. The cluster size will be increased from %v to %v.",
 							p.DeploymentID, oldResourceConfig.ComponentName, oldResourceConfig.Cluster, newResourceConfig.Cluster)
-						_ = ReplaceValueInRequestTemplate(
-							actionTemplateDataMap, "_cluster", newResourceConfig.Cluster)
+						if err := setClusterInActionTemplate(resourceActionTemplate.Data, newResourceConfig.ComponentName, newResourceConfig.Cluster); err != nil {
+							return err
+						}
 						requestID, err := vraClient.PostResourceAction(p.DeploymentID, scaleOutActionID, resourceActionTemplate)
 						if err != nil {
 							log.Errorf("The scale out request failed with error: %v ", err)
@@ -327,13 +472,12 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 						}
 						resourceActionTemplate.Description = d.Get("description").(string)
 						resourceActionTemplate.Reasons = d.Get("reasons").(string)
-						// get the map from the action template corresponding to the key which is the component name
-						actionTemplateDataMap := GetActionTemplateDataByComponent(resourceActionTemplate.Data, newResourceConfig.ComponentName)
 						// update the template with the new cluster size
 						log.Info("Starting Scale In action on the deployment with id %v for the component %v. The cluster size will be decresed from %v to %v.",
 							p.DeploymentID, oldResourceConfig.ComponentName, oldResourceConfig.Cluster, newResourceConfig.Cluster)
-						_ = ReplaceValueInRequestTemplate(
-							actionTemplateDataMap, "_cluster", newResourceConfig.Cluster)
+						if err := setClusterInActionTemplate(resourceActionTemplate.Data, newResourceConfig.ComponentName, newResourceConfig.Cluster); err != nil {
+							return err
+						}
 						requestID, err := vraClient.PostResourceAction(p.DeploymentID, scaleInActionID, resourceActionTemplate)
 						if err != nil {
 							log.Errorf("The scale in request failed with error: %v ", err)
@@ -351,6 +495,8 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 
+		// a machine with a scheduled request that has not run yet is not reconfigured again
+		scheduledRequests := readScheduledRequests(vraClient, d.Get("scheduled_reconfigure_requests").(map[string]interface{}))
 		for _, newRC := range newResourceConfigList {
 			cName := newRC.ComponentName
 			index, oldRC := GetResourceConfigurationByComponent(oldResourceConfigList, cName)
@@ -358,6 +504,7 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 			if index != -1 {
 				newConfig := newRC.Configuration
 				for _, instance := range oldRC.Instances {
+					scheduledRequestID := scheduledRequests[instance.ResourceID]
 					vmResourceActions, err := vraClient.GetResourceActions(instance.ResourceID)
 					if err != nil {
 						return err
@@ -370,17 +517,40 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 						resourceActionTemplate.Reasons = d.Get("reasons").(string)
 						configChanged := false
 						actionTemplateDataMap := resourceActionTemplate.Data
+						root := getReconfigureRoot(actionTemplateDataMap, cName)
 						// checking if any property has changed in the new configuration
-						for propertyName, propertyValue := range newConfig {
+						for _, propertyName := range sortedKeys(newConfig) {
+							propertyValue := newConfig[propertyName]
 							if oldRC.Configuration[propertyName] != propertyValue {
-								_ = ReplaceValueInRequestTemplate(
-									actionTemplateDataMap, propertyName, propertyValue)
-								if !configChanged {
-									configChanged = true
+								replaced, err := ReplaceValueInRequestTemplate(actionTemplateDataMap, root, propertyName, propertyValue)
+								if err != nil {
+									return err
+								}
+								if !replaced {
+									log.Warning("The property %v is not in the Reconfigure action template of the component %v, it is not updated.", propertyName, cName)
+									continue
 								}
+								configChanged = true
 							}
 						}
+						// add or resize the disks declared in the disk blocks
+						if len(newRC.Disks) > 0 {
+							disksChanged, err := updateDisksInActionTemplate(actionTemplateDataMap, cName, newRC.Disks)
+							if err != nil {
+								return err
+							}
+							configChanged = configChanged || disksChanged
+						}
+						// add, update or remove the network adapters declared in the network_adapter blocks
+						if len(newRC.NetworkAdapters) > 0 {
+							nicsChanged := updateNetworkAdaptersInActionTemplate(actionTemplateDataMap, cName, newRC.NetworkAdapters)
+							configChanged = configChanged || nicsChanged
+						}
 						if configChanged {
+							if scheduledRequestID != "" {
+								return fmt.Errorf(ScheduledRequestPendingError, scheduledRequestID, cName)
+							}
+							p.ReconfigureOptions.applyToActionTemplate(actionTemplateDataMap)
 							log.Info("Starting Reconfigure action on the component %v.", cName)
 							requestID, err := vraClient.PostResourceAction(instance.ResourceID, reconfigureActionID, resourceActionTemplate)
 							if err != nil {
@@ -388,6 +558,13 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 								return err
 							}
 							log.Info("The Reconfigure operation for the component %v has been submitted", cName)
+							// a scheduled request only runs in the maintenance window, there is nothing to wait for
+							if p.ReconfigureOptions.isScheduled() {
+								log.Info("The Reconfigure action on the component %v is scheduled at %v.", cName, p.ReconfigureOptions.ScheduledTime)
+								scheduledRequests[instance.ResourceID] = requestID
+								d.Set("scheduled_reconfigure_requests", scheduledRequests)
+								continue
+							}
 							_, err = waitForRequestCompletion(d, meta, requestID)
 							if err != nil {
 								log.Errorf("The reconfigure request for component %v failed with error: %v ", cName, err)
@@ -402,8 +579,8 @@ func resourceVra7DeploymentUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// the description and reasons cannot be updated without any valid day-2 opearation
-	if (d.HasChange("description") || d.HasChange("reasons")) && (!d.HasChange("lease_days") && !d.HasChange("resource_configuration")) {
-		return fmt.Errorf("Updating only description and/or reasons is not supported. You can update them during any supported Day-2 actions")
+	if isDescriptionOnlyChange(d) {
+		return fmt.Errorf(DescriptionOnlyUpdateError)
 	}
 
 	log.Info("Finished updating the resource vra7_deployment with request id %s", d.Id())
@@ -430,6 +607,10 @@ func resourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
+	if deploymentID == "" {
+		// a standalone XaaS catalog item provisions its resource without a deployment
+		return readXaaSResource(d, vraClient, catalogItemRequestID)
+	}
 	// Since the resource view API above do not provide the cluster value, it is calculated
 	// by tracking the component name and updated in the state file
 	clusterCountMap := make(map[string]int)
@@ -443,16 +624,35 @@ func resourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	resource, err := vraClient.GetResource(deploymentID)
+	if err != nil {
+		return err
+	}
+
 	d.Set("catalog_item_id", deployment.CatalogItem.ID)
 	d.Set("catalog_item_name", deployment.CatalogItem.Label)
 	d.Set("deployment_id", deploymentID)
-	d.Set("description", deployment.Description)
+	readTerraformMarker(d, deployment.Description)
 	d.Set("created_date", deployment.CreatedDate)
 	d.Set("expiry_date", deployment.ExpiryDate)
 	d.Set("name", deployment.Name)
 	d.Set("businessgroup_id", deployment.Subtenant.ID)
 	d.Set("businessgroup_name", deployment.Subtenant.Label)
 
+	days, err := daysUntilExpiry(deployment.ExpiryDate, time.Now())
+	if err != nil {
+		return err
+	}
+	d.Set("days_until_expiry", days)
+	d.Set("status", resource.Status)
+	state := leaseState(resource.Status, deployment.ExpiryDate, time.Now())
+	d.Set("lease_state", state)
+	if isLeaseOver(state) {
+		log.Warning("The deployment %s is %s, its machines are powered off and day-2 operations are not available.", deploymentID, state)
+	} else {
+		warnIfLeaseExpiring(deploymentID, days, p.AutoExtendWithinDays)
+	}
+
 	owners := make([]map[string]string, 0)
 	for _, owner := range deployment.Owners {
 		ownerMap := make(map[string]string)
@@ -462,20 +662,28 @@ func resourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("owners", owners)
 
-	for _, component := range deployment.Components {
+	scheduledRequests := readScheduledRequests(vraClient, d.Get("scheduled_reconfigure_requests").(map[string]interface{}))
+	d.Set("scheduled_reconfigure_requests", scheduledRequests)
 
-		if component.Type == sdk.InfrastructureVirtual {
+	componentsByID := getDeploymentComponentsByID(deployment.Components)
+	var configuredResources []sdk.ResourceConfigurationStruct
+	if p != nil {
+		configuredResources = p.ResourceConfiguration
+	}
+	xaasResources := make([]sdk.XaaSResource, 0)
+	for _, component := range deployment.Components {
 
-			data := component.Data
-			componentName := data["Component"].(string)
+		if isXaaSResourceType(component.Type) {
+			xaasResources = append(xaasResources, newXaaSResourceFromComponent(component, getDeploymentComponentName(component, componentsByID)))
+		}
+		// a resource_configuration that predates the hierarchical names keeps the names of the components alone
+		componentName := getConfiguredComponentName(component, componentsByID, configuredResources)
+		// the machines are always read, the software components and the nested blueprints when they are configured
+		configuredIndex, _ := GetResourceConfigurationByComponent(configuredResources, componentName)
+		if component.Type == sdk.InfrastructureVirtual || configuredIndex != -1 {
 
 			if componentName != "" {
-				instance := sdk.Instance{}
-				instance.IPAddress = data["ip_address"].(string)
-				instance.Name = component.Name
-				instance.ResourceID = component.ID
-				instance.ResourceType = component.Type
-				instance.Properties = data
+				instance := newInstance(component)
 
 				// checking to see if a resource configuration struct exists for the component name
 				// if yes, then add another instance to the instances list of that resource config struct
@@ -489,14 +697,24 @@ func resourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) error
 					rcStruct.ParentResourceID = component.ParentID
 					if p != nil && p.ResourceConfiguration != nil {
 						rcStruct.Configuration = GetConfiguration(componentName, p.ResourceConfiguration)
+						_, configuredRC := GetResourceConfigurationByComponent(p.ResourceConfiguration, componentName)
+						rcStruct.Disks = refreshDiskConfigurations(configuredRC.Disks, instance)
+						rcStruct.NetworkAdapters = refreshNetworkAdapterConfigurations(configuredRC.NetworkAdapters, instance)
 					}
 					rcStruct.Instances = make([]sdk.Instance, 0)
 					rcStruct.Instances = append(rcStruct.Instances, instance)
 					resourceConfigList = append(resourceConfigList, rcStruct)
+					index = len(resourceConfigList) - 1
 				} else {
 					rcStruct.Instances = append(rcStruct.Instances, instance)
 					resourceConfigList[index] = rcStruct
 				}
+				// the disks and network adapters of a scheduled request are kept as configured until it runs
+				if scheduledRequests[instance.ResourceID] != "" && p != nil {
+					_, configuredRC := GetResourceConfigurationByComponent(p.ResourceConfiguration, componentName)
+					resourceConfigList[index].Disks = configuredRC.Disks
+					resourceConfigList[index].NetworkAdapters = configuredRC.NetworkAdapters
+				}
 				clusterCountMap[componentName] = clusterCountMap[componentName] + 1
 			}
 		}
@@ -505,6 +723,9 @@ func resourceVra7DeploymentRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("resource_configuration", flattenResourceConfigurations(resourceConfigList, clusterCountMap)); err != nil {
 		return fmt.Errorf("error setting resource configuration - error: %v", err)
 	}
+	if err := d.Set("xaas_resources", flattenXaaSResources(xaasResources)); err != nil {
+		return fmt.Errorf("error setting xaas resources - error: %v", err)
+	}
 
 	log.Info("Finished reading the resource vra7_deployment with request id %s", d.Id())
 	return nil
@@ -529,7 +750,14 @@ func resourceVra7DeploymentDelete(d *schema.ResourceData, meta interface{}) erro
 	deploymentResourceActions, _ := vraClient.GetResourceActions(deploymentID)
 	deploymentActionsMap := GetActionNameIDMap(deploymentResourceActions)
 	destroyActionID := deploymentActionsMap[p.DeploymentDestroyAction]
-	if p.DeploymentDestroy && destroyActionID != "" {
+	if p.DeploymentDestroy && destroyActionID == "" {
+		// vRA destroys an expired deployment at the end of its archive period
+		if state := d.Get("lease_state").(string); isLeaseOver(state) {
+			return fmt.Errorf(LeaseOverDestroyError, deploymentID, state, p.DeploymentDestroyAction)
+		}
+		return fmt.Errorf(DestroyActionNotFoundError, p.DeploymentDestroyAction, deploymentID)
+	}
+	if p.DeploymentDestroy {
 		resourceActionTemplate, _ := vraClient.GetResourceActionTemplate(deploymentID, destroyActionID)
 		requestID, err := vraClient.PostResourceAction(deploymentID, destroyActionID, resourceActionTemplate)
 		if err != nil {
@@ -560,21 +788,11 @@ func (p *ProviderSchema) checkResourceConfigValidity(client *sdk.APIClient) (*sd
 	}
 	log.Info("The request template data corresponding to the catalog item %v is: \n %v\n", p.CatalogItemID, requestTemplate.Data)
 
-	// Get all component names in the blueprint corresponding to the catalog item.
-	componentSet := make(map[string]bool)
-	for field := range requestTemplate.Data {
-		if reflect.ValueOf(requestTemplate.Data[field]).Kind() == reflect.Map {
-			componentSet[field] = true
-		}
-	}
-	log.Info("The component name(s) in the blueprint corresponding to the catalog item: %v\n", componentSet)
-
 	var invalidKeys []string
-	// check if the component of resource_configuration map exists in the componentSet
-	// retrieved from catalog item request template
-
+	// check if the component of resource_configuration map exists in the catalog item request template,
+	// the components of nested blueprints and the software components are addressed by their hierarchical name
 	for _, k := range p.ResourceConfiguration {
-		if _, ok := componentSet[k.ComponentName]; !ok {
+		if lookupComponentPath(requestTemplate.Data, k.ComponentName) == nil {
 			invalidKeys = append(invalidKeys, k.ComponentName)
 		}
 	}
@@ -612,8 +830,153 @@ func checkConfigValuesValidity(d *schema.ResourceData) error {
 	return nil
 }
 
+// forceNewKeys are the arguments that no day-2 action can update, changing them replaces the deployment
+var forceNewKeys = []string{"catalog_item_id", "catalog_item_name", "businessgroup_id", "businessgroup_name", "deployment_configuration"}
+
+// dayTwoKeys are the arguments whose changes trigger a day-2 action, the description and reasons are sent
+// with it. A planned days_until_expiry is the lease extended by auto_extend_within_days.
+var dayTwoKeys = []string{"lease_days", "expiry_date", "resource_configuration", "days_until_expiry"}
+
+// resourceChangeChecker reads the changes of the arguments of the resource, applied (schema.ResourceData)
+// or planned (schema.ResourceDiff)
+type resourceChangeChecker interface {
+	HasChange(key string) bool
+}
+
+// isDescriptionOnlyChange returns true if the description or the reasons change without any day-2 action
+func isDescriptionOnlyChange(d resourceChangeChecker) bool {
+	if !d.HasChange("description") && !d.HasChange("reasons") {
+		return false
+	}
+	for _, key := range dayTwoKeys {
+		if d.HasChange(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// check the changes that cannot be applied in place: the arguments that replace the deployment,
+// and description and reasons that are only updated along with a day-2 action
+func checkForceNewChanges(d *schema.ResourceDiff) error {
+	// recreate_if_expired replaces the deployment by planning a new lease_state
+	replaced := d.HasChange("lease_state")
+	for _, key := range forceNewKeys {
+		if !d.HasChange(key) {
+			continue
+		}
+		// the names are read back from the deployment, a name that is not in the config does not replace it
+		if strings.HasSuffix(key, "_name") && d.Get(key).(string) == "" {
+			continue
+		}
+		if err := d.ForceNew(key); err != nil {
+			return err
+		}
+		replaced = true
+	}
+	if !replaced && isDescriptionOnlyChange(d) {
+		return fmt.Errorf(DescriptionOnlyUpdateError)
+	}
+	return nil
+}
+
+// check that the changed configuration properties can be updated by the Reconfigure action of the machines,
+// the deployment is replaced otherwise
+func checkConfigurationChanges(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("resource_configuration") || !d.NewValueKnown("resource_configuration") || isLeaseOver(d.Get("lease_state").(string)) {
+		return nil
+	}
+	vraClient := meta.(*sdk.APIClient)
+	old, new := d.GetChange("resource_configuration")
+	oldResourceConfigList := expandResourceConfiguration(old.(*schema.Set).List())
+	newResourceConfigList := expandResourceConfiguration(new.(*schema.Set).List())
+	for _, newRC := range newResourceConfigList {
+		index, oldRC := GetResourceConfigurationByComponent(oldResourceConfigList, newRC.ComponentName)
+		if index == -1 || len(oldRC.Instances) == 0 {
+			continue
+		}
+		var changedProperties []string
+		for propertyName, propertyValue := range newRC.Configuration {
+			if oldRC.Configuration[propertyName] != propertyValue {
+				changedProperties = append(changedProperties, propertyName)
+			}
+		}
+		if len(changedProperties) == 0 {
+			continue
+		}
+		// the machines of a component share the same Reconfigure action template
+		instance := oldRC.Instances[0]
+		vmResourceActions, err := vraClient.GetResourceActions(instance.ResourceID)
+		if err != nil {
+			return err
+		}
+		reconfigureActionID := GetActionNameIDMap(vmResourceActions)[sdk.Reconfigure]
+		var actionTemplateData map[string]interface{}
+		if reconfigureActionID != "" {
+			resourceActionTemplate, err := vraClient.GetResourceActionTemplate(instance.ResourceID, reconfigureActionID)
+			if err != nil {
+				return err
+			}
+			actionTemplateData = resourceActionTemplate.Data
+		}
+		properties, err := getNonReconfigurableProperties(actionTemplateData, newRC.ComponentName, changedProperties)
+		if err != nil {
+			return err
+		}
+		if len(properties) > 0 {
+			log.Info("The properties %v of the component %v cannot be reconfigured, the deployment is replaced.", properties, newRC.ComponentName)
+			return d.ForceNew("resource_configuration")
+		}
+	}
+	return nil
+}
+
+// getNonReconfigurableProperties returns the sorted properties of the component that are not in the
+// Reconfigure action template
+func getNonReconfigurableProperties(actionTemplateData map[string]interface{}, componentName string, properties []string) ([]string, error) {
+	var nonReconfigurable []string
+	root := getReconfigureRoot(actionTemplateData, componentName)
+	for _, propertyName := range properties {
+		contained, err := ContainsKeyInRequestTemplate(actionTemplateData, root, propertyName)
+		if err != nil {
+			return nil, err
+		}
+		if !contained {
+			nonReconfigurable = append(nonReconfigurable, propertyName)
+		}
+	}
+	sort.Strings(nonReconfigurable)
+	return nonReconfigurable, nil
+}
+
+// check that none of the disks declared in the disk blocks of the resource_configuration is shrunk
+func checkDiskChanges(d *schema.ResourceDiff) error {
+	if !d.HasChange("resource_configuration") {
+		return nil
+	}
+	old, new := d.GetChange("resource_configuration")
+	oldResourceConfigList := expandResourceConfiguration(old.(*schema.Set).List())
+	newResourceConfigList := expandResourceConfiguration(new.(*schema.Set).List())
+	for _, newRC := range newResourceConfigList {
+		index, oldRC := GetResourceConfigurationByComponent(oldResourceConfigList, newRC.ComponentName)
+		if index == -1 || len(newRC.Disks) == 0 {
+			continue
+		}
+		if err := checkDiskShrink(newRC.ComponentName, newRC.Disks, oldRC.Instances); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceGetter reads the arguments of the resource, from the state and config (schema.ResourceData)
+// or from the planned changes (schema.ResourceDiff)
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
 // read the config file
-func readProviderConfiguration(d *schema.ResourceData, vraClient *sdk.APIClient) (*ProviderSchema, error) {
+func readProviderConfiguration(d resourceGetter, vraClient *sdk.APIClient) (*ProviderSchema, error) {
 	log.Info("Reading the provider configuration data.....")
 	providerSchema := ProviderSchema{
 		CatalogItemName:         strings.TrimSpace(d.Get("catalog_item_name").(string)),
@@ -629,6 +992,9 @@ func readProviderConfiguration(d *schema.ResourceData, vraClient *sdk.APIClient)
 		DeploymentDestroy:       d.Get("deployment_destroy").(bool),
 		DeploymentDestroyAction: d.Get("deployment_destroy_action").(string),
 		DeploymentConfiguration: d.Get("deployment_configuration").(map[string]interface{}),
+		ReconfigureOptions:      expandReconfigureOptions(d.Get("reconfigure_options").([]interface{})),
+		AutoExtendWithinDays:    d.Get("auto_extend_within_days").(int),
+		TerraformMarker:         d.Get("terraform_marker").(string),
 	}
 
 	// if catalog item name is provided, fetch the catalog item id
@@ -682,16 +1048,39 @@ func waitForRequestCompletion(d *schema.ResourceData, meta interface{}, requestI
 	return "", fmt.Errorf("Request has timed out with status %s. \nRun terraform refresh to get the latest state of your request", status)
 }
 
-// GetActionTemplateDataByComponent return the map corresponding the component name in the template data
-func GetActionTemplateDataByComponent(actionTemplate map[string]interface{}, componentName string) map[string]interface{} {
-	actionTemplateDataByComponent := make(map[string]interface{})
-	for key, value := range actionTemplate {
-		if key == componentName && reflect.ValueOf(value).Kind() == reflect.Map {
-			actionTemplateDataByComponent = value.(map[string]interface{})
-			break
-		}
+// setClusterInActionTemplate sets the cluster size of the component in a Scale In or Scale Out action template
+func setClusterInActionTemplate(actionTemplate map[string]interface{}, componentName string, cluster int) error {
+	component := getActionComponentPath(actionTemplate, componentName)
+	if component == nil {
+		return fmt.Errorf(sdk.TemplatePathNotFoundError, componentName)
+	}
+	componentData := component.Append("data")
+	replaced, err := ReplaceValueInRequestTemplate(actionTemplate, componentData, sdk.Cluster, cluster)
+	if err == nil && !replaced {
+		err = fmt.Errorf(sdk.TemplatePathNotFoundError, componentData.Append(sdk.Cluster))
+	}
+	return err
+}
+
+// getActionComponentPath returns the path of the component in the data of an action template, or nil
+// if the component is not in the template. The action template of a nested blueprint only has the
+// components of that blueprint, the component is then looked up by its own name.
+func getActionComponentPath(actionTemplate map[string]interface{}, componentName string) sdk.TemplatePath {
+	component := lookupComponentPath(actionTemplate, componentName)
+	if component == nil {
+		component = lookupComponentPath(actionTemplate, componentName[strings.LastIndex(componentName, ComponentNameSeparator)+1:])
+	}
+	return component
+}
+
+// getReconfigureRoot returns the path of the data of the component in a Reconfigure action template,
+// or nil for the flat template of a machine that has the properties at its root
+func getReconfigureRoot(actionTemplate map[string]interface{}, componentName string) sdk.TemplatePath {
+	component := getActionComponentPath(actionTemplate, componentName)
+	if component == nil {
+		return nil
 	}
-	return actionTemplateDataByComponent
+	return component.Append("data")
 }
 
 // GetResourceConfigurationByComponent returns the resource_configuration corresponding the component
@@ -93,6 +93,101 @@ func TestConfigValidityFunction(t *testing.T) {
 	utils.AssertNil(t, mockRequestTemplateStruct)
 }
 
+// changedKeys is a resourceChangeChecker with the keys that change
+type changedKeys map[string]bool
+
+func (c changedKeys) HasChange(key string) bool {
+	return c[key]
+}
+
+func TestIsDescriptionOnlyChange(t *testing.T) {
+	utils.AssertFalse(t, "no change", isDescriptionOnlyChange(changedKeys{}))
+	utils.AssertTrue(t, "description alone", isDescriptionOnlyChange(changedKeys{"description": true}))
+	utils.AssertTrue(t, "reasons alone", isDescriptionOnlyChange(changedKeys{"reasons": true}))
+	utils.AssertFalse(t, "description with a lease change", isDescriptionOnlyChange(changedKeys{"description": true, "lease_days": true}))
+	// the lease extended by auto_extend_within_days is a day-2 action for both plan and apply
+	utils.AssertFalse(t, "description with an auto extension", isDescriptionOnlyChange(changedKeys{"description": true, "days_until_expiry": true}))
+}
+
+func TestGetNonReconfigurableProperties(t *testing.T) {
+	templateData := mockReconfigureTemplateData()
+
+	properties, err := getNonReconfigurableProperties(templateData, "vSphere1", []string{"cpu", "memory", "description"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 0, len(properties))
+
+	properties, err = getNonReconfigurableProperties(templateData, "vSphere1", []string{"os_type", "cpu", "machine_prefix"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 2, len(properties))
+	utils.AssertEqualsString(t, "machine_prefix", properties[0])
+	utils.AssertEqualsString(t, "os_type", properties[1])
+
+	// without a Reconfigure action, no property can be reconfigured
+	properties, err = getNonReconfigurableProperties(nil, "vSphere1", []string{"cpu"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(properties))
+
+	// the Reconfigure action template of a nested component has the properties in the data of the component
+	templateData = map[string]interface{}{
+		"description": "reconfigure",
+		"Web": map[string]interface{}{
+			"componentTypeId": "com.vmware.csp.iaas.blueprint.service",
+			"data": map[string]interface{}{
+				"cpu":    1,
+				"memory": 1024,
+			},
+		},
+	}
+	properties, err = getNonReconfigurableProperties(templateData, "App.Web", []string{"cpu", "memory", "description"})
+	utils.AssertNilError(t, err)
+	utils.AssertEqualsInt(t, 1, len(properties))
+	utils.AssertEqualsString(t, "description", properties[0])
+
+	replaced, err := ReplaceValueInRequestTemplate(templateData, getReconfigureRoot(templateData, "App.Web"), "cpu", 2)
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "cpu replaced", replaced)
+	utils.AssertEqualsInt(t, 2, templateData["Web"].(map[string]interface{})["data"].(map[string]interface{})["cpu"].(int))
+}
+
+func TestMergeIntoRequestTemplate(t *testing.T) {
+	var requestTemplate sdk.CatalogItemRequestTemplate
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockRequestTemplate), &requestTemplate))
+	// a nested map of the component with its own cpu and port properties
+	componentData := requestTemplate.Data["mock.test.machine1"].(map[string]interface{})["data"].(map[string]interface{})
+	componentData["software"] = map[string]interface{}{"cpu": 1, "port": 8080}
+
+	p := &ProviderSchema{
+		ResourceConfiguration: []sdk.ResourceConfigurationStruct{
+			{
+				ComponentName: "mock.test.machine1",
+				Cluster:       2,
+				Configuration: map[string]interface{}{"cpu": "4", "port": "9090", "custom.property": "value"},
+			},
+		},
+	}
+	utils.AssertNilError(t, p.mergeIntoRequestTemplate(&requestTemplate))
+	utils.AssertEqualsInt(t, 4, convToInt(componentData["cpu"]))
+	utils.AssertEqualsInt(t, 2, convToInt(componentData["_cluster"]))
+	utils.AssertEqualsString(t, "value", componentData["custom.property"].(string))
+	// a property missing from the component data is added to it, the nested maps are left alone
+	utils.AssertEqualsInt(t, 9090, convToInt(componentData["port"]))
+	software := componentData["software"].(map[string]interface{})
+	utils.AssertEqualsInt(t, 1, convToInt(software["cpu"]))
+	utils.AssertEqualsInt(t, 8080, convToInt(software["port"]))
+
+	// a nested map is reached by an explicit path
+	p.ResourceConfiguration[0].Configuration = map[string]interface{}{"software.port": "8443"}
+	utils.AssertNilError(t, p.mergeIntoRequestTemplate(&requestTemplate))
+	utils.AssertEqualsInt(t, 8443, convToInt(software["port"]))
+
+	// a key that is both a property of the component and a path is ambiguous
+	componentData["software.port"] = 80
+	err := p.mergeIntoRequestTemplate(&requestTemplate)
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(sdk.AmbiguousTemplatePathError, "software.port",
+		`["mock.test.machine1"].data["software.port"], ["mock.test.machine1"].data.software.port`), err.Error())
+}
+
 func TestAccVra7Deployment(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
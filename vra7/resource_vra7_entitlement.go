@@ -0,0 +1,328 @@
+package vra7
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func resourceVra7Entitlement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7EntitlementCreate,
+		Read:   resourceVra7EntitlementRead,
+		Update: resourceVra7EntitlementUpdate,
+		Delete: resourceVra7EntitlementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"businessgroup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sdk.EntitlementStatusActive,
+				ValidateFunc: validateStringInSlice([]string{sdk.EntitlementStatusActive, sdk.EntitlementStatusInactive, sdk.EntitlementStatusDraft}),
+			},
+			"all_users": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"local_scope_for_actions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"principal": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStringInSlice([]string{sdk.PrincipalTypeUser, sdk.PrincipalTypeGroup}),
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"entitled_service": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"approval_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"entitled_catalog_item": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_item_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"approval_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"entitled_action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"approval_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVra7EntitlementCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_entitlement %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	entitlement := &sdk.Entitlement{}
+	expandEntitlement(d, vraClient.Tenant, entitlement)
+	created, err := vraClient.CreateEntitlement(entitlement)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID)
+	log.Info("Finished creating the resource vra7_entitlement with id %s", d.Id())
+	return resourceVra7EntitlementRead(d, meta)
+}
+
+func resourceVra7EntitlementRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_entitlement with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	entitlement, err := vraClient.GetEntitlement(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The entitlement %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", entitlement.Name)
+	d.Set("description", entitlement.Description)
+	d.Set("businessgroup_id", entitlement.Organization.SubtenantRef)
+	d.Set("status", entitlement.Status)
+	d.Set("all_users", entitlement.AllUsers)
+	d.Set("local_scope_for_actions", entitlement.LocalScopeForActions)
+	if err := d.Set("principal", flattenEntitlementPrincipals(entitlement.Principals)); err != nil {
+		return err
+	}
+	if err := d.Set("entitled_service", flattenEntitledServices(entitlement.EntitledServices)); err != nil {
+		return err
+	}
+	if err := d.Set("entitled_catalog_item", flattenEntitledCatalogItems(entitlement.EntitledCatalogItems)); err != nil {
+		return err
+	}
+	if err := d.Set("entitled_action", flattenEntitledResourceOperations(entitlement.EntitledResourceOperations)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7EntitlementUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_entitlement with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// the update replaces the current version of the entitlement
+	entitlement, err := vraClient.GetEntitlement(d.Id())
+	if err != nil {
+		return err
+	}
+	expandEntitlement(d, vraClient.Tenant, entitlement)
+	if _, err := vraClient.UpdateEntitlement(entitlement); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_entitlement with id %s", d.Id())
+	return resourceVra7EntitlementRead(d, meta)
+}
+
+func resourceVra7EntitlementDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_entitlement with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	entitlement, err := vraClient.GetEntitlement(d.Id())
+	if sdk.IsNotFoundError(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// vRA only deletes the entitlements that are not active
+	if entitlement.Status == sdk.EntitlementStatusActive {
+		entitlement.Status = sdk.EntitlementStatusInactive
+		if _, err := vraClient.UpdateEntitlement(entitlement); err != nil {
+			return err
+		}
+	}
+	if err := vraClient.DeleteEntitlement(d.Id()); err != nil {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_entitlement")
+	return nil
+}
+
+// expandEntitlement sets the arguments of the resource in the entitlement
+func expandEntitlement(d *schema.ResourceData, tenant string, entitlement *sdk.Entitlement) {
+	entitlement.Name = d.Get("name").(string)
+	entitlement.Description = d.Get("description").(string)
+	entitlement.TenantRef = tenant
+	entitlement.Organization.TenantRef = tenant
+	entitlement.Organization.SubtenantRef = d.Get("businessgroup_id").(string)
+	entitlement.Status = d.Get("status").(string)
+	entitlement.AllUsers = d.Get("all_users").(bool)
+	entitlement.LocalScopeForActions = d.Get("local_scope_for_actions").(bool)
+	entitlement.Principals = expandEntitlementPrincipals(d.Get("principal").(*schema.Set).List(), tenant)
+	entitlement.EntitledServices = expandEntitledServices(d.Get("entitled_service").(*schema.Set).List())
+	entitlement.EntitledCatalogItems = expandEntitledCatalogItems(d.Get("entitled_catalog_item").(*schema.Set).List())
+	entitlement.EntitledResourceOperations = expandEntitledResourceOperations(d.Get("entitled_action").(*schema.Set).List())
+}
+
+func expandEntitlementPrincipals(principals []interface{}, tenant string) []sdk.Principal {
+	expanded := make([]sdk.Principal, 0, len(principals))
+	for _, p := range principals {
+		principalMap := p.(map[string]interface{})
+		expanded = append(expanded, sdk.Principal{
+			TenantName: tenant,
+			Ref:        principalMap["ref"].(string),
+			Type:       principalMap["type"].(string),
+		})
+	}
+	return expanded
+}
+
+func flattenEntitlementPrincipals(principals []sdk.Principal) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(principals))
+	for _, principal := range principals {
+		flattened = append(flattened, map[string]interface{}{
+			"type": principal.Type,
+			"ref":  principal.Ref,
+		})
+	}
+	return flattened
+}
+
+func expandEntitledServices(services []interface{}) []sdk.EntitledService {
+	expanded := make([]sdk.EntitledService, 0, len(services))
+	for _, s := range services {
+		serviceMap := s.(map[string]interface{})
+		expanded = append(expanded, sdk.EntitledService{
+			ServiceRef:       sdk.Reference{ID: serviceMap["service_id"].(string)},
+			ApprovalPolicyID: serviceMap["approval_policy_id"].(string),
+			Active:           true,
+		})
+	}
+	return expanded
+}
+
+func flattenEntitledServices(services []sdk.EntitledService) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(services))
+	for _, service := range services {
+		flattened = append(flattened, map[string]interface{}{
+			"service_id":         service.ServiceRef.ID,
+			"approval_policy_id": service.ApprovalPolicyID,
+		})
+	}
+	return flattened
+}
+
+func expandEntitledCatalogItems(catalogItems []interface{}) []sdk.EntitledCatalogItem {
+	expanded := make([]sdk.EntitledCatalogItem, 0, len(catalogItems))
+	for _, c := range catalogItems {
+		catalogItemMap := c.(map[string]interface{})
+		expanded = append(expanded, sdk.EntitledCatalogItem{
+			CatalogItemRef:   sdk.Reference{ID: catalogItemMap["catalog_item_id"].(string)},
+			ApprovalPolicyID: catalogItemMap["approval_policy_id"].(string),
+			Active:           true,
+		})
+	}
+	return expanded
+}
+
+func flattenEntitledCatalogItems(catalogItems []sdk.EntitledCatalogItem) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(catalogItems))
+	for _, catalogItem := range catalogItems {
+		flattened = append(flattened, map[string]interface{}{
+			"catalog_item_id":    catalogItem.CatalogItemRef.ID,
+			"approval_policy_id": catalogItem.ApprovalPolicyID,
+		})
+	}
+	return flattened
+}
+
+func expandEntitledResourceOperations(actions []interface{}) []sdk.EntitledResourceOperation {
+	expanded := make([]sdk.EntitledResourceOperation, 0, len(actions))
+	for _, a := range actions {
+		actionMap := a.(map[string]interface{})
+		expanded = append(expanded, sdk.EntitledResourceOperation{
+			ExternalID:            actionMap["action"].(string),
+			ResourceOperationType: sdk.ResourceOperationTypeAction,
+			TargetResourceTypeRef: sdk.Reference{ID: actionMap["resource_type"].(string)},
+			ApprovalPolicyID:      actionMap["approval_policy_id"].(string),
+			Active:                true,
+		})
+	}
+	return expanded
+}
+
+func flattenEntitledResourceOperations(actions []sdk.EntitledResourceOperation) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(actions))
+	for _, action := range actions {
+		flattened = append(flattened, map[string]interface{}{
+			"action":             action.ExternalID,
+			"resource_type":      action.TargetResourceTypeRef.ID,
+			"approval_policy_id": action.ApprovalPolicyID,
+		})
+	}
+	return flattened
+}
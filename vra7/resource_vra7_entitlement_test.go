@@ -0,0 +1,72 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestExpandEntitlement(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceVra7Entitlement().Schema, map[string]interface{}{
+		"name":             "Development",
+		"businessgroup_id": "6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+		"principal": []interface{}{
+			map[string]interface{}{"type": sdk.PrincipalTypeGroup, "ref": "developers@example.com"},
+		},
+		"entitled_catalog_item": []interface{}{
+			map[string]interface{}{"catalog_item_id": "feaedf73-560c-4612-a573-41667e017691", "approval_policy_id": "c0ffee00"},
+		},
+		"entitled_action": []interface{}{
+			map[string]interface{}{"action": "Infrastructure.Machine.Action.PowerOn", "resource_type": sdk.InfrastructureVirtual},
+		},
+	})
+
+	entitlement := &sdk.Entitlement{ID: "b2b5c2a4", Version: 3}
+	expandEntitlement(d, "vsphere.local", entitlement)
+	utils.AssertEqualsString(t, "b2b5c2a4", entitlement.ID)
+	utils.AssertEqualsInt(t, 3, entitlement.Version)
+	utils.AssertEqualsString(t, sdk.EntitlementStatusActive, entitlement.Status)
+	utils.AssertTrue(t, "local scope for actions by default", entitlement.LocalScopeForActions)
+	utils.AssertEqualsString(t, "6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a", entitlement.Organization.SubtenantRef)
+	utils.AssertEqualsString(t, "vsphere.local", entitlement.Principals[0].TenantName)
+	utils.AssertEqualsString(t, "developers@example.com", entitlement.Principals[0].Ref)
+	utils.AssertEqualsInt(t, 0, len(entitlement.EntitledServices))
+	utils.AssertEqualsString(t, "c0ffee00", entitlement.EntitledCatalogItems[0].ApprovalPolicyID)
+	utils.AssertTrue(t, "catalog item active", entitlement.EntitledCatalogItems[0].Active)
+	utils.AssertEqualsString(t, sdk.ResourceOperationTypeAction, entitlement.EntitledResourceOperations[0].ResourceOperationType)
+	utils.AssertEqualsString(t, sdk.InfrastructureVirtual, entitlement.EntitledResourceOperations[0].TargetResourceTypeRef.ID)
+}
+
+func TestResourceVra7EntitlementRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "b2b5c2a4-1f6e-4c1a-9a3b-0b1c1d2e3f40"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.EntitlementAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockEntitlement))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7Entitlement().Schema, map[string]interface{}{})
+	d.SetId(id)
+	utils.AssertNilError(t, resourceVra7EntitlementRead(d, &client))
+	utils.AssertEqualsString(t, "Development", d.Get("name").(string))
+	utils.AssertEqualsString(t, sdk.EntitlementStatusInactive, d.Get("status").(string))
+	utils.AssertEqualsInt(t, 1, d.Get("principal").(*schema.Set).Len())
+	utils.AssertEqualsInt(t, 0, d.Get("entitled_service").(*schema.Set).Len())
+	catalogItem := d.Get("entitled_catalog_item").(*schema.Set).List()[0].(map[string]interface{})
+	utils.AssertEqualsString(t, "c0ffee00-0000-4000-8000-000000000002", catalogItem["approval_policy_id"].(string))
+	action := d.Get("entitled_action").(*schema.Set).List()[0].(map[string]interface{})
+	utils.AssertEqualsString(t, "Infrastructure.Machine.Action.PowerOn", action["action"].(string))
+
+	// an entitlement deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7EntitlementRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
@@ -0,0 +1,348 @@
+package vra7
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// NetworkProfileFieldError is returned when a field that a type of network profile needs is not set
+const NetworkProfileFieldError = "%s is required by a %s network profile"
+
+// networkProfileTypeFields are the fields that the types of network profiles need besides the common ones
+var networkProfileTypeFields = map[string][]string{
+	sdk.NetworkProfileTypeExternal: {},
+	sdk.NetworkProfileTypeNAT:      {"external_network_profile_id", "nat_type"},
+	sdk.NetworkProfileTypeRouted:   {"external_network_profile_id", "range_subnet_mask", "base_ip"},
+}
+
+func resourceVra7NetworkProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7NetworkProfileCreate,
+		Read:   resourceVra7NetworkProfileRead,
+		Update: resourceVra7NetworkProfileUpdate,
+		Delete: resourceVra7NetworkProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceVra7NetworkProfileCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringInSlice([]string{sdk.NetworkProfileTypeExternal, sdk.NetworkProfileTypeNAT, sdk.NetworkProfileTypeRouted}),
+			},
+			"subnet_mask": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"gateway": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"primary_dns": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secondary_dns": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_search_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"primary_wins": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secondary_wins": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_network_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"nat_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringInSlice([]string{sdk.NATTypeOneToOne, sdk.NATTypeOneToMany}),
+			},
+			"range_subnet_mask": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"base_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ip_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"allocated_ip_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_ip_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"allocated_ip_address": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"range_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"machine_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceVra7NetworkProfileCustomizeDiff checks the fields of the type of network profile, and plans the
+// new IP address counts when the ranges change
+func resourceVra7NetworkProfileCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	profileType := d.Get("type").(string)
+	for _, field := range networkProfileTypeFields[profileType] {
+		if d.NewValueKnown(field) && d.Get(field).(string) == "" {
+			return fmt.Errorf(NetworkProfileFieldError, field, profileType)
+		}
+	}
+	if d.HasChange("ip_range") {
+		for _, field := range []string{"ip_address_count", "allocated_ip_address_count", "free_ip_address_count", "allocated_ip_address"} {
+			if err := d.SetNewComputed(field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceVra7NetworkProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_network_profile %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	profile := sdk.NewNetworkProfile(d.Get("type").(string))
+	expandNetworkProfile(d, profile)
+	created, err := vraClient.CreateNetworkProfile(profile)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID)
+	log.Info("Finished creating the resource vra7_network_profile with id %s", d.Id())
+	return resourceVra7NetworkProfileRead(d, meta)
+}
+
+func resourceVra7NetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_network_profile with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	profile, err := vraClient.GetNetworkProfile(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The network profile %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("type", profile.ProfileType)
+	d.Set("subnet_mask", profile.SubnetMask)
+	d.Set("gateway", profile.GatewayAddress)
+	d.Set("primary_dns", profile.PrimaryDNSAddress)
+	d.Set("secondary_dns", profile.SecondaryDNSAddress)
+	d.Set("dns_suffix", profile.DNSSuffix)
+	d.Set("dns_search_suffix", profile.DNSSearchSuffix)
+	d.Set("primary_wins", profile.PrimaryWinsAddress)
+	d.Set("secondary_wins", profile.SecondaryWinsAddress)
+	d.Set("external_network_profile_id", profile.ExternalNetworkProfileID)
+	d.Set("nat_type", profile.NATType)
+	d.Set("range_subnet_mask", profile.RangeSubnetMask)
+	d.Set("base_ip", profile.BaseIP)
+	if err := d.Set("ip_range", flattenNetworkRanges(profile.DefinedRanges)); err != nil {
+		return err
+	}
+
+	total, allocated := networkProfileAllocation(profile.DefinedRanges)
+	d.Set("ip_address_count", total)
+	d.Set("allocated_ip_address_count", len(allocated))
+	d.Set("free_ip_address_count", total-len(allocated))
+	if err := d.Set("allocated_ip_address", allocated); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7NetworkProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_network_profile with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// the update replaces the current network profile, the ranges that are kept keep their ids
+	profile, err := vraClient.GetNetworkProfile(d.Id())
+	if err != nil {
+		return err
+	}
+	expandNetworkProfile(d, profile)
+	if _, err := vraClient.UpdateNetworkProfile(profile); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_network_profile with id %s", d.Id())
+	return resourceVra7NetworkProfileRead(d, meta)
+}
+
+func resourceVra7NetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_network_profile with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	err := vraClient.DeleteNetworkProfile(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_network_profile")
+	return nil
+}
+
+// expandNetworkProfile sets the arguments of the resource in the network profile
+func expandNetworkProfile(d *schema.ResourceData, profile *sdk.IaaSNetworkProfile) {
+	profile.Name = d.Get("name").(string)
+	profile.Description = d.Get("description").(string)
+	profile.SubnetMask = d.Get("subnet_mask").(string)
+	profile.GatewayAddress = d.Get("gateway").(string)
+	profile.PrimaryDNSAddress = d.Get("primary_dns").(string)
+	profile.SecondaryDNSAddress = d.Get("secondary_dns").(string)
+	profile.DNSSuffix = d.Get("dns_suffix").(string)
+	profile.DNSSearchSuffix = d.Get("dns_search_suffix").(string)
+	profile.PrimaryWinsAddress = d.Get("primary_wins").(string)
+	profile.SecondaryWinsAddress = d.Get("secondary_wins").(string)
+	profile.ExternalNetworkProfileID = d.Get("external_network_profile_id").(string)
+	profile.NATType = d.Get("nat_type").(string)
+	profile.RangeSubnetMask = d.Get("range_subnet_mask").(string)
+	profile.BaseIP = d.Get("base_ip").(string)
+	profile.DefinedRanges = expandNetworkRanges(d.Get("ip_range").([]interface{}), profile.DefinedRanges)
+}
+
+// expandNetworkRanges returns the ranges of the ip_range blocks. A range with the same name as a current range
+// is that range, with its id and its IP addresses.
+func expandNetworkRanges(ranges []interface{}, current []sdk.NetworkRange) []sdk.NetworkRange {
+	currentByName := make(map[string]sdk.NetworkRange, len(current))
+	for _, r := range current {
+		currentByName[r.Name] = r
+	}
+	expanded := make([]sdk.NetworkRange, 0, len(ranges))
+	for _, r := range ranges {
+		rangeMap := r.(map[string]interface{})
+		networkRange := currentByName[rangeMap["name"].(string)]
+		networkRange.Name = rangeMap["name"].(string)
+		networkRange.Description = rangeMap["description"].(string)
+		networkRange.BeginIPv4Address = rangeMap["start_address"].(string)
+		networkRange.EndIPv4Address = rangeMap["end_address"].(string)
+		expanded = append(expanded, networkRange)
+	}
+	return expanded
+}
+
+func flattenNetworkRanges(ranges []sdk.NetworkRange) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		flattened = append(flattened, map[string]interface{}{
+			"name":          r.Name,
+			"description":   r.Description,
+			"start_address": r.BeginIPv4Address,
+			"end_address":   r.EndIPv4Address,
+		})
+	}
+	return flattened
+}
+
+// networkProfileAllocation returns the number of IP addresses of the ranges, and the allocated IP addresses
+func networkProfileAllocation(ranges []sdk.NetworkRange) (int, []map[string]interface{}) {
+	total := 0
+	allocated := []map[string]interface{}{}
+	for _, r := range ranges {
+		total += networkRangeSize(r.BeginIPv4Address, r.EndIPv4Address)
+		for _, address := range r.DefinedAddresses {
+			if address.State != sdk.IPAddressStateAllocated {
+				continue
+			}
+			allocated = append(allocated, map[string]interface{}{
+				"address":      address.IPv4Address,
+				"range_name":   r.Name,
+				"machine_name": address.VirtualMachineName,
+			})
+		}
+	}
+	return total, allocated
+}
+
+// networkRangeSize returns the number of IPv4 addresses from the start to the end address, or 0 if they are not
+// IPv4 addresses
+func networkRangeSize(start, end string) int {
+	startIP, endIP := net.ParseIP(start).To4(), net.ParseIP(end).To4()
+	if startIP == nil || endIP == nil {
+		return 0
+	}
+	first, last := binary.BigEndian.Uint32(startIP), binary.BigEndian.Uint32(endIP)
+	if last < first {
+		return 0
+	}
+	return int(last-first) + 1
+}
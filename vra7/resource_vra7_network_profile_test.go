@@ -0,0 +1,66 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestNetworkRangeSize(t *testing.T) {
+	utils.AssertEqualsInt(t, 10, networkRangeSize("10.10.0.10", "10.10.0.19"))
+	utils.AssertEqualsInt(t, 1, networkRangeSize("10.10.0.10", "10.10.0.10"))
+	utils.AssertEqualsInt(t, 512, networkRangeSize("10.10.0.0", "10.10.1.255"))
+	utils.AssertEqualsInt(t, 0, networkRangeSize("10.10.0.19", "10.10.0.10"))
+	utils.AssertEqualsInt(t, 0, networkRangeSize("fe80::1", "fe80::10"))
+}
+
+func TestExpandNetworkRanges(t *testing.T) {
+	current := []sdk.NetworkRange{
+		{ID: "r-1", Name: "servers", BeginIPv4Address: "10.10.0.10", EndIPv4Address: "10.10.0.19",
+			DefinedAddresses: []sdk.NetworkIPAddress{{IPv4Address: "10.10.0.10", State: sdk.IPAddressStateAllocated}}},
+		{ID: "r-2", Name: "desktops"},
+	}
+	ranges := expandNetworkRanges([]interface{}{
+		map[string]interface{}{"name": "servers", "description": "", "start_address": "10.10.0.10", "end_address": "10.10.0.29"},
+		map[string]interface{}{"name": "printers", "description": "", "start_address": "10.10.0.200", "end_address": "10.10.0.209"},
+	}, current)
+	utils.AssertEqualsInt(t, 2, len(ranges))
+	utils.AssertEqualsString(t, "r-1", ranges[0].ID)
+	utils.AssertEqualsString(t, "10.10.0.29", ranges[0].EndIPv4Address)
+	utils.AssertEqualsInt(t, 1, len(ranges[0].DefinedAddresses))
+	utils.AssertEqualsString(t, "", ranges[1].ID)
+}
+
+func TestResourceVra7NetworkProfileRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "3e5f7091-0000-4000-8000-000000000030"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.NetworkProfileAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockNetworkProfile))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7NetworkProfile().Schema, map[string]interface{}{})
+	d.SetId(id)
+	utils.AssertNilError(t, resourceVra7NetworkProfileRead(d, &client))
+	utils.AssertEqualsString(t, sdk.NetworkProfileTypeExternal, d.Get("type").(string))
+	utils.AssertEqualsString(t, "255.255.255.0", d.Get("subnet_mask").(string))
+	utils.AssertEqualsString(t, "", d.Get("secondary_dns").(string))
+	utils.AssertEqualsString(t, "desktops", d.Get("ip_range.1.name").(string))
+	utils.AssertEqualsInt(t, 110, d.Get("ip_address_count").(int))
+	utils.AssertEqualsInt(t, 2, d.Get("allocated_ip_address_count").(int))
+	utils.AssertEqualsInt(t, 108, d.Get("free_ip_address_count").(int))
+	utils.AssertEqualsString(t, "dev-web-02", d.Get("allocated_ip_address.1.machine_name").(string))
+
+	// a network profile deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7NetworkProfileRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
@@ -0,0 +1,387 @@
+package vra7
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// error constants
+const (
+	PropertyDefinitionFieldError = "%s is not allowed for a %s property definition"
+	PropertyDefinitionValueError = "%s: %s is not a valid %s value"
+)
+
+// propertyDefinitionTypeFields are the constraints that the data types of property definitions allow
+var propertyDefinitionTypeFields = map[string][]string{
+	sdk.PropertyDataTypeString:       {"min_length", "max_length", "static_value", "external_values"},
+	sdk.PropertyDataTypeInteger:      {"min_value", "max_value", "increment", "static_value", "external_values"},
+	sdk.PropertyDataTypeDecimal:      {"min_value", "max_value", "increment", "static_value", "external_values"},
+	sdk.PropertyDataTypeBoolean:      {},
+	sdk.PropertyDataTypeSecureString: {"min_length", "max_length"},
+}
+
+// propertyDefinitionNumberFacets are the facets of the bounds of the integer and decimal property definitions,
+// by field. Their values are written as strings, like the values of the static lists, so that 0 is a bound.
+var propertyDefinitionNumberFacets = map[string]string{
+	"min_value": sdk.MinValueFacet,
+	"max_value": sdk.MaxValueFacet,
+	"increment": sdk.IncrementFacet,
+}
+
+func resourceVra7PropertyDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7PropertyDefinitionCreate,
+		Read:   resourceVra7PropertyDefinitionRead,
+		Update: resourceVra7PropertyDefinitionUpdate,
+		Delete: resourceVra7PropertyDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceVra7PropertyDefinitionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateStringInSlice([]string{sdk.PropertyDataTypeString, sdk.PropertyDataTypeInteger,
+					sdk.PropertyDataTypeDecimal, sdk.PropertyDataTypeBoolean, sdk.PropertyDataTypeSecureString}),
+			},
+			"display_advice": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validateStringInSlice([]string{sdk.DisplayAdviceTextBox, sdk.DisplayAdviceTextArea, sdk.DisplayAdviceDropDown,
+					sdk.DisplayAdviceSlider, sdk.DisplayAdviceCheckBox, sdk.DisplayAdviceYesNo, sdk.DisplayAdviceEmail}),
+			},
+			"order_index": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"min_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"increment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"max_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"static_value": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"external_values"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"external_values": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"static_value"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceVra7PropertyDefinitionCustomizeDiff checks that the constraints are allowed by the data type, and
+// that the bounds and the values of the static list are values of the data type
+func resourceVra7PropertyDefinitionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	dataType := d.Get("data_type").(string)
+	allowed := make(map[string]bool)
+	for _, field := range propertyDefinitionTypeFields[dataType] {
+		allowed[field] = true
+	}
+	for _, field := range []string{"min_value", "max_value", "increment", "min_length", "max_length", "static_value", "external_values"} {
+		if _, ok := d.GetOk(field); ok && !allowed[field] {
+			return fmt.Errorf(PropertyDefinitionFieldError, field, dataType)
+		}
+	}
+	for field := range propertyDefinitionNumberFacets {
+		if value, ok := d.GetOk(field); ok && allowed[field] {
+			if _, err := parseDataTypeValue(dataType, value.(string)); err != nil {
+				return fmt.Errorf(PropertyDefinitionValueError, field, value.(string), dataType)
+			}
+		}
+	}
+	if !d.NewValueKnown("static_value") {
+		return nil
+	}
+	for i, v := range d.Get("static_value").([]interface{}) {
+		value := v.(map[string]interface{})["value"].(string)
+		if _, err := parseDataTypeValue(dataType, value); err != nil {
+			return fmt.Errorf(PropertyDefinitionValueError, fmt.Sprintf("static_value.%d.value", i), value, dataType)
+		}
+	}
+	return nil
+}
+
+func resourceVra7PropertyDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_property_definition %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	definition := sdk.NewPropertyDefinition(d.Get("name").(string), d.Get("data_type").(string))
+	if err := expandPropertyDefinition(d, vraClient.Tenant, definition); err != nil {
+		return err
+	}
+	created, err := vraClient.CreatePropertyDefinition(definition)
+	if err != nil {
+		return err
+	}
+	// the name of a property definition is its id in the properties service
+	d.SetId(created.Name)
+	log.Info("Finished creating the resource vra7_property_definition with id %s", d.Id())
+	return resourceVra7PropertyDefinitionRead(d, meta)
+}
+
+func resourceVra7PropertyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_property_definition with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	definition, err := vraClient.GetPropertyDefinition(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The property definition %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", definition.Name)
+	d.Set("label", definition.Label)
+	d.Set("description", definition.Description)
+	d.Set("data_type", definition.DataType.TypeID)
+	d.Set("display_advice", definition.DisplayAdvice)
+	orderIndex := 0
+	if definition.OrderIndex != nil {
+		orderIndex = *definition.OrderIndex
+	}
+	d.Set("order_index", orderIndex)
+	d.Set("required", definition.IsMandatory())
+	for field, facetType := range propertyDefinitionNumberFacets {
+		d.Set(field, definition.Constant(facetType).String())
+	}
+	d.Set("min_length", definition.Constant(sdk.MinLengthFacet).Int())
+	d.Set("max_length", definition.Constant(sdk.MaxLengthFacet).Int())
+	if err := d.Set("static_value", flattenStaticValues(definition.StaticValues())); err != nil {
+		return err
+	}
+	if err := d.Set("external_values", flattenExternalValues(definition.ExternalValues())); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7PropertyDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_property_definition with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// the update replaces the current property definition, the facets the resource does not manage are kept
+	definition, err := vraClient.GetPropertyDefinition(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := expandPropertyDefinition(d, vraClient.Tenant, definition); err != nil {
+		return err
+	}
+	if _, err := vraClient.UpdatePropertyDefinition(definition); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_property_definition with id %s", d.Id())
+	return resourceVra7PropertyDefinitionRead(d, meta)
+}
+
+func resourceVra7PropertyDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_property_definition with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	err := vraClient.DeletePropertyDefinition(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_property_definition")
+	return nil
+}
+
+// expandPropertyDefinition sets the arguments of the resource in the property definition
+func expandPropertyDefinition(d *schema.ResourceData, tenant string, definition *sdk.PropertyDefinition) error {
+	dataType := definition.DataType.TypeID
+	definition.TenantID = tenant
+	definition.Label = d.Get("label").(string)
+	if definition.Label == "" {
+		definition.Label = definition.Name
+	}
+	definition.Description = d.Get("description").(string)
+	definition.DisplayAdvice = d.Get("display_advice").(string)
+	definition.OrderIndex = nil
+	if orderIndex, ok := d.GetOk("order_index"); ok {
+		index := orderIndex.(int)
+		definition.OrderIndex = &index
+	}
+
+	var mandatory *sdk.LiteralValue
+	if d.Get("required").(bool) {
+		mandatory = sdk.NewBooleanValue(true)
+	}
+	definition.SetConstant(sdk.MandatoryFacet, mandatory)
+	for field, facetType := range propertyDefinitionNumberFacets {
+		var value *sdk.LiteralValue
+		if v, ok := d.GetOk(field); ok {
+			number, err := parseDataTypeValue(dataType, v.(string))
+			if err != nil {
+				return fmt.Errorf(PropertyDefinitionValueError, field, v.(string), dataType)
+			}
+			value = sdk.NewPropertyValue(dataType, number)
+		}
+		definition.SetConstant(facetType, value)
+	}
+	for field, facetType := range map[string]string{"min_length": sdk.MinLengthFacet, "max_length": sdk.MaxLengthFacet} {
+		var value *sdk.LiteralValue
+		if v, ok := d.GetOk(field); ok {
+			value = sdk.NewIntegerValue(v.(int))
+		}
+		definition.SetConstant(facetType, value)
+	}
+
+	delete(definition.Facets, sdk.PermissibleValuesFacet)
+	if values := d.Get("static_value").([]interface{}); len(values) > 0 {
+		staticValues, err := expandStaticValues(dataType, values)
+		if err != nil {
+			return err
+		}
+		definition.Facets[sdk.PermissibleValuesFacet] = &sdk.PropertyClause{Type: sdk.StaticClause, Value: staticValues}
+	}
+	if sources := d.Get("external_values").([]interface{}); len(sources) > 0 && sources[0] != nil {
+		definition.Facets[sdk.PermissibleValuesFacet] = &sdk.PropertyClause{Type: sdk.ExternalClause, Value: expandExternalValues(sources[0].(map[string]interface{}))}
+	}
+	return nil
+}
+
+// parseDataTypeValue returns the value of the data type written in the configuration
+func parseDataTypeValue(dataType, value string) (interface{}, error) {
+	switch dataType {
+	case sdk.PropertyDataTypeInteger:
+		return strconv.Atoi(value)
+	case sdk.PropertyDataTypeDecimal:
+		return strconv.ParseFloat(value, 64)
+	case sdk.PropertyDataTypeBoolean:
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
+
+func expandStaticValues(dataType string, values []interface{}) ([]sdk.PermissibleValue, error) {
+	expanded := make([]sdk.PermissibleValue, 0, len(values))
+	for _, v := range values {
+		valueMap := v.(map[string]interface{})
+		value, err := parseDataTypeValue(dataType, valueMap["value"].(string))
+		if err != nil {
+			return nil, fmt.Errorf(PropertyDefinitionValueError, "static_value", valueMap["value"].(string), dataType)
+		}
+		expanded = append(expanded, sdk.PermissibleValue{
+			UnderlyingValue: sdk.NewPropertyValue(dataType, value),
+			Label:           valueMap["label"].(string),
+		})
+	}
+	return expanded, nil
+}
+
+func flattenStaticValues(values []sdk.PermissibleValue) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(values))
+	for _, value := range values {
+		flattened = append(flattened, map[string]interface{}{
+			"value": value.UnderlyingValue.String(),
+			"label": value.Label,
+		})
+	}
+	return flattened
+}
+
+func expandExternalValues(source map[string]interface{}) *sdk.ExternalValueSource {
+	parameters := source["parameters"].(map[string]interface{})
+	expanded := &sdk.ExternalValueSource{ID: source["action"].(string)}
+	for _, name := range sortedKeys(parameters) {
+		expanded.Parameters = append(expanded.Parameters, sdk.ExternalValueParameter{
+			Name:  name,
+			Value: sdk.NewConstantClause(sdk.NewPropertyValue(sdk.PropertyDataTypeString, parameters[name].(string))),
+		})
+	}
+	return expanded
+}
+
+func flattenExternalValues(source *sdk.ExternalValueSource) []map[string]interface{} {
+	if source == nil {
+		return nil
+	}
+	parameters := make(map[string]interface{}, len(source.Parameters))
+	for _, parameter := range source.Parameters {
+		if parameter.Value == nil {
+			continue
+		}
+		if value, ok := parameter.Value.Value.(*sdk.LiteralValue); ok {
+			parameters[parameter.Name] = value.String()
+		}
+	}
+	return []map[string]interface{}{{
+		"action":     source.ID,
+		"parameters": parameters,
+	}}
+}
@@ -0,0 +1,93 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestExpandPropertyDefinition(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceVra7PropertyDefinition().Schema, map[string]interface{}{
+		"name":           "Custom.CPU",
+		"data_type":      sdk.PropertyDataTypeInteger,
+		"display_advice": sdk.DisplayAdviceDropDown,
+		"required":       true,
+		"min_value":      "0",
+		"max_value":      "8",
+		"static_value": []interface{}{
+			map[string]interface{}{"value": "2", "label": "Small"},
+			map[string]interface{}{"value": "4"},
+		},
+	})
+	definition := sdk.NewPropertyDefinition("Custom.CPU", sdk.PropertyDataTypeInteger)
+	utils.AssertNilError(t, expandPropertyDefinition(d, "vsphere.local", definition))
+	utils.AssertEqualsString(t, "Custom.CPU", definition.Label)
+	utils.AssertTrue(t, "no order index", definition.OrderIndex == nil)
+	utils.AssertTrue(t, "mandatory", definition.IsMandatory())
+	// 0 is a bound
+	utils.AssertEqualsString(t, sdk.LiteralTypeInteger, definition.Constant(sdk.MinValueFacet).Type)
+	utils.AssertEqualsInt(t, 0, definition.Constant(sdk.MinValueFacet).Int())
+	utils.AssertEqualsInt(t, 8, definition.Constant(sdk.MaxValueFacet).Int())
+	utils.AssertTrue(t, "no increment", definition.Constant(sdk.IncrementFacet) == nil)
+	values := definition.StaticValues()
+	utils.AssertEqualsInt(t, 2, len(values))
+	utils.AssertEqualsInt(t, 4, values[1].UnderlyingValue.Int())
+
+	d = schema.TestResourceDataRaw(t, resourceVra7PropertyDefinition().Schema, map[string]interface{}{
+		"name":      "Custom.CPU",
+		"data_type": sdk.PropertyDataTypeInteger,
+		"max_value": "8.5",
+	})
+	err := expandPropertyDefinition(d, "vsphere.local", sdk.NewPropertyDefinition("Custom.CPU", sdk.PropertyDataTypeInteger))
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(PropertyDefinitionValueError, "max_value", "8.5", sdk.PropertyDataTypeInteger), err.Error())
+}
+
+func TestExpandExternalValues(t *testing.T) {
+	source := expandExternalValues(map[string]interface{}{
+		"action":     "com.example.storage/getDatastores",
+		"parameters": map[string]interface{}{"site": "paris", "cluster": "c1"},
+	})
+	utils.AssertEqualsString(t, "com.example.storage/getDatastores", source.ID)
+	utils.AssertEqualsInt(t, 2, len(source.Parameters))
+	utils.AssertEqualsString(t, "cluster", source.Parameters[0].Name)
+
+	flattened := flattenExternalValues(source)
+	utils.AssertEqualsString(t, "paris", flattened[0]["parameters"].(map[string]interface{})["site"].(string))
+	utils.AssertTrue(t, "no external list", flattenExternalValues(nil) == nil)
+}
+
+func TestResourceVra7PropertyDefinitionRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	name := "VirtualMachine.Network0.Name"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.PropertyDefinitionAPI, name), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockPropertyDefinition))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7PropertyDefinition().Schema, map[string]interface{}{})
+	d.SetId(name)
+	utils.AssertNilError(t, resourceVra7PropertyDefinitionRead(d, &client))
+	utils.AssertEqualsString(t, "Network", d.Get("label").(string))
+	utils.AssertEqualsString(t, sdk.PropertyDataTypeString, d.Get("data_type").(string))
+	utils.AssertEqualsString(t, sdk.DisplayAdviceDropDown, d.Get("display_advice").(string))
+	utils.AssertEqualsInt(t, 2, d.Get("order_index").(int))
+	utils.AssertTrue(t, "required", d.Get("required").(bool))
+	utils.AssertEqualsString(t, "", d.Get("min_value").(string))
+	utils.AssertEqualsString(t, "prod-net", d.Get("static_value.1.value").(string))
+	utils.AssertEqualsString(t, "Production", d.Get("static_value.1.label").(string))
+	utils.AssertEqualsInt(t, 0, len(d.Get("external_values").([]interface{})))
+
+	// a property definition deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7PropertyDefinitionRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
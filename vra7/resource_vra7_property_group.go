@@ -0,0 +1,182 @@
+package vra7
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+func resourceVra7PropertyGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVra7PropertyGroupCreate,
+		Read:   resourceVra7PropertyGroupRead,
+		Update: resourceVra7PropertyGroupUpdate,
+		Delete: resourceVra7PropertyGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"property": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"show_in_request": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVra7PropertyGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_property_group %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	group := &sdk.PropertyGroup{Name: d.Get("name").(string)}
+	expandPropertyGroup(d, vraClient.Tenant, group)
+	created, err := vraClient.CreatePropertyGroup(group)
+	if err != nil {
+		return err
+	}
+	// the name of a property group is its id in the properties service
+	d.SetId(created.Name)
+	log.Info("Finished creating the resource vra7_property_group with id %s", d.Id())
+	return resourceVra7PropertyGroupRead(d, meta)
+}
+
+func resourceVra7PropertyGroupRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_property_group with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	group, err := vraClient.GetPropertyGroup(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The property group %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", group.Name)
+	d.Set("label", group.Label)
+	d.Set("description", group.Description)
+	if err := d.Set("property", flattenGroupProperties(group.Properties, d.Get("property").(*schema.Set).List())); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7PropertyGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_property_group with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	group, err := vraClient.GetPropertyGroup(d.Id())
+	if err != nil {
+		return err
+	}
+	expandPropertyGroup(d, vraClient.Tenant, group)
+	if _, err := vraClient.UpdatePropertyGroup(group); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_property_group with id %s", d.Id())
+	return resourceVra7PropertyGroupRead(d, meta)
+}
+
+func resourceVra7PropertyGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_property_group with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	err := vraClient.DeletePropertyGroup(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_property_group")
+	return nil
+}
+
+// expandPropertyGroup sets the arguments of the resource in the property group
+func expandPropertyGroup(d *schema.ResourceData, tenant string, group *sdk.PropertyGroup) {
+	group.TenantID = tenant
+	group.Label = d.Get("label").(string)
+	if group.Label == "" {
+		group.Label = group.Name
+	}
+	group.Description = d.Get("description").(string)
+	group.Properties = expandGroupProperties(d.Get("property").(*schema.Set).List())
+}
+
+func expandGroupProperties(properties []interface{}) map[string]*sdk.GroupProperty {
+	expanded := make(map[string]*sdk.GroupProperty, len(properties))
+	for _, p := range properties {
+		propertyMap := p.(map[string]interface{})
+		dataType := sdk.PropertyDataTypeString
+		if propertyMap["encrypted"].(bool) {
+			dataType = sdk.PropertyDataTypeSecureString
+		}
+		expanded[propertyMap["name"].(string)] = &sdk.GroupProperty{
+			DefaultValue: sdk.NewPropertyValue(dataType, propertyMap["value"].(string)),
+			Encrypted:    propertyMap["encrypted"].(bool),
+			Visible:      propertyMap["show_in_request"].(bool),
+		}
+	}
+	return expanded
+}
+
+// flattenGroupProperties returns the property blocks of the properties of the group. vRA does not return the
+// values of the encrypted properties, they keep the values of the current property blocks.
+func flattenGroupProperties(properties map[string]*sdk.GroupProperty, current []interface{}) []map[string]interface{} {
+	currentValues := make(map[string]string, len(current))
+	for _, p := range current {
+		propertyMap := p.(map[string]interface{})
+		currentValues[propertyMap["name"].(string)] = propertyMap["value"].(string)
+	}
+	flattened := make([]map[string]interface{}, 0, len(properties))
+	for name, property := range properties {
+		value := property.DefaultValue.String()
+		if property.Encrypted {
+			value = currentValues[name]
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"name":            name,
+			"value":           value,
+			"encrypted":       property.Encrypted,
+			"show_in_request": property.Visible,
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,61 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestExpandGroupProperties(t *testing.T) {
+	properties := expandGroupProperties([]interface{}{
+		map[string]interface{}{"name": "Custom.Role", "value": "web", "encrypted": false, "show_in_request": true},
+		map[string]interface{}{"name": "Custom.Password", "value": "secret", "encrypted": true, "show_in_request": false},
+	})
+	utils.AssertEqualsInt(t, 2, len(properties))
+	utils.AssertEqualsString(t, sdk.LiteralTypeString, properties["Custom.Role"].DefaultValue.Type)
+	utils.AssertTrue(t, "visible", properties["Custom.Role"].Visible)
+	utils.AssertEqualsString(t, sdk.LiteralTypeSecureString, properties["Custom.Password"].DefaultValue.Type)
+	utils.AssertEqualsString(t, "secret", properties["Custom.Password"].DefaultValue.String())
+}
+
+func TestResourceVra7PropertyGroupRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	name := "WebServers"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.PropertyGroupAPI, name), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockPropertyGroup))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7PropertyGroup().Schema, map[string]interface{}{
+		"name": name,
+		"property": []interface{}{
+			map[string]interface{}{"name": "Custom.Password", "value": "secret", "encrypted": true},
+		},
+	})
+	d.SetId(name)
+	utils.AssertNilError(t, resourceVra7PropertyGroupRead(d, &client))
+	utils.AssertEqualsString(t, "Web servers", d.Get("label").(string))
+
+	values := make(map[string]string)
+	for _, p := range d.Get("property").(*schema.Set).List() {
+		property := p.(map[string]interface{})
+		values[property["name"].(string)] = property["value"].(string)
+	}
+	utils.AssertEqualsInt(t, 2, len(values))
+	utils.AssertEqualsString(t, "web", values["Custom.Role"])
+	// vRA masks the value of an encrypted property, the configured value is kept
+	utils.AssertEqualsString(t, "secret", values["Custom.Password"])
+
+	// a property group deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7PropertyGroupRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
@@ -0,0 +1,356 @@
+package vra7
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// reservationAlertFields maps the fields of the alerts block to the ids of the alerts of a reservation
+var reservationAlertFields = map[string]string{
+	"storage_percent": sdk.StorageAlert,
+	"memory_percent":  sdk.MemoryAlert,
+	"cpu_percent":     sdk.CPUAlert,
+	"machine_percent": sdk.MachineAlert,
+}
+
+func resourceVra7Reservation() *schema.Resource {
+	alertSchema := map[string]*schema.Schema{
+		"recipients": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"email_business_group_managers": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"reminder_frequency_days": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
+		},
+	}
+	for field := range reservationAlertFields {
+		alertSchema[field] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  80,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceVra7ReservationCreate,
+		Read:   resourceVra7ReservationRead,
+		Update: resourceVra7ReservationUpdate,
+		Delete: resourceVra7ReservationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"reservation_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  sdk.ReservationTypeVSphere,
+				ForceNew: true,
+			},
+			"businessgroup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compute_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reservation_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"machine_quota": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"memory_mb": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"storage": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"size_gb": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"network": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_profile_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: alertSchema,
+				},
+			},
+		},
+	}
+}
+
+func resourceVra7ReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Creating the resource vra7_reservation %s", d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	reservation := &sdk.Reservation{}
+	expandReservation(d, vraClient.Tenant, reservation)
+	created, err := vraClient.CreateReservation(reservation)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID)
+	log.Info("Finished creating the resource vra7_reservation with id %s", d.Id())
+	return resourceVra7ReservationRead(d, meta)
+}
+
+func resourceVra7ReservationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Reading the resource vra7_reservation with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	reservation, err := vraClient.GetReservation(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The reservation %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	extensionData := &reservation.ExtensionData
+	d.Set("name", reservation.Name)
+	d.Set("reservation_type", reservation.ReservationTypeID)
+	d.Set("businessgroup_id", reservation.SubTenantID)
+	d.Set("compute_resource_id", extensionData.Get(sdk.ComputeResourceKey).EntityID())
+	d.Set("reservation_policy_id", reservation.ReservationPolicyID)
+	d.Set("priority", reservation.Priority)
+	d.Set("enabled", reservation.Enabled)
+	d.Set("machine_quota", extensionData.Get(sdk.MachineQuotaKey).Int())
+	memory := extensionData.Get(sdk.ReservationMemoryKey)
+	if memory != nil {
+		d.Set("memory_mb", memory.Values.Get(sdk.MemoryReservedSizeKey).Int())
+	}
+	if err := d.Set("storage", flattenReservationStorages(extensionData.Get(sdk.ReservationStoragesKey))); err != nil {
+		return err
+	}
+	if err := d.Set("network", flattenReservationNetworks(extensionData.Get(sdk.ReservationNetworksKey))); err != nil {
+		return err
+	}
+	if err := d.Set("alerts", flattenReservationAlertPolicy(reservation.AlertPolicy)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceVra7ReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Updating the resource vra7_reservation with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	// the update replaces the current version of the reservation, its other extension data is kept
+	reservation, err := vraClient.GetReservation(d.Id())
+	if err != nil {
+		return err
+	}
+	expandReservation(d, vraClient.Tenant, reservation)
+	if _, err := vraClient.UpdateReservation(reservation); err != nil {
+		return err
+	}
+	log.Info("Finished updating the resource vra7_reservation with id %s", d.Id())
+	return resourceVra7ReservationRead(d, meta)
+}
+
+func resourceVra7ReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the resource vra7_reservation with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	err := vraClient.DeleteReservation(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the resource vra7_reservation")
+	return nil
+}
+
+// expandReservation sets the arguments of the resource in the reservation
+func expandReservation(d *schema.ResourceData, tenant string, reservation *sdk.Reservation) {
+	reservation.Name = d.Get("name").(string)
+	reservation.ReservationTypeID = d.Get("reservation_type").(string)
+	reservation.TenantID = tenant
+	reservation.SubTenantID = d.Get("businessgroup_id").(string)
+	reservation.ReservationPolicyID = d.Get("reservation_policy_id").(string)
+	reservation.Priority = d.Get("priority").(int)
+	reservation.Enabled = d.Get("enabled").(bool)
+	reservation.AlertPolicy = expandReservationAlertPolicy(d.Get("alerts").([]interface{}))
+
+	extensionData := &reservation.ExtensionData
+	extensionData.Set(sdk.ComputeResourceKey, sdk.NewEntityRef(sdk.ComputeResourceClass, d.Get("compute_resource_id").(string)))
+	extensionData.Set(sdk.MachineQuotaKey, sdk.NewIntegerValue(d.Get("machine_quota").(int)))
+	extensionData.Set(sdk.ReservationMemoryKey, sdk.NewComplexValue(sdk.ReservationMemoryClass,
+		sdk.ExtensionDataEntry{Key: sdk.MemoryReservedSizeKey, Value: sdk.NewIntegerValue(d.Get("memory_mb").(int))}))
+	extensionData.Set(sdk.ReservationStoragesKey, expandReservationStorages(d.Get("storage").(*schema.Set).List()))
+	extensionData.Set(sdk.ReservationNetworksKey, expandReservationNetworks(d.Get("network").(*schema.Set).List()))
+}
+
+func expandReservationStorages(storages []interface{}) *sdk.LiteralValue {
+	items := make([]*sdk.LiteralValue, 0, len(storages))
+	for _, s := range storages {
+		storageMap := s.(map[string]interface{})
+		items = append(items, sdk.NewComplexValue(sdk.ReservationStorageClass,
+			sdk.ExtensionDataEntry{Key: sdk.StoragePathKey, Value: sdk.NewEntityRef(sdk.StorageClass, storageMap["path_id"].(string))},
+			sdk.ExtensionDataEntry{Key: sdk.StorageReservedSizeKey, Value: sdk.NewIntegerValue(storageMap["size_gb"].(int))},
+			sdk.ExtensionDataEntry{Key: sdk.StoragePriorityKey, Value: sdk.NewIntegerValue(storageMap["priority"].(int))},
+			sdk.ExtensionDataEntry{Key: sdk.StorageEnabledKey, Value: sdk.NewBooleanValue(storageMap["enabled"].(bool))}))
+	}
+	return sdk.NewMultipleValue(items)
+}
+
+func flattenReservationStorages(storages *sdk.LiteralValue) []map[string]interface{} {
+	if storages == nil {
+		return []map[string]interface{}{}
+	}
+	flattened := make([]map[string]interface{}, 0, len(storages.Items))
+	for _, storage := range storages.Items {
+		flattened = append(flattened, map[string]interface{}{
+			"path_id":  storage.Values.Get(sdk.StoragePathKey).EntityID(),
+			"size_gb":  storage.Values.Get(sdk.StorageReservedSizeKey).Int(),
+			"priority": storage.Values.Get(sdk.StoragePriorityKey).Int(),
+			"enabled":  storage.Values.Get(sdk.StorageEnabledKey).Bool(),
+		})
+	}
+	return flattened
+}
+
+func expandReservationNetworks(networks []interface{}) *sdk.LiteralValue {
+	items := make([]*sdk.LiteralValue, 0, len(networks))
+	for _, n := range networks {
+		networkMap := n.(map[string]interface{})
+		entries := []sdk.ExtensionDataEntry{
+			{Key: sdk.NetworkPathKey, Value: sdk.NewEntityRef(sdk.NetworkClass, networkMap["path_id"].(string))},
+		}
+		if profileID := networkMap["network_profile_id"].(string); profileID != "" {
+			entries = append(entries, sdk.ExtensionDataEntry{Key: sdk.NetworkProfileKey, Value: sdk.NewEntityRef(sdk.NetworkProfileClass, profileID)})
+		}
+		items = append(items, sdk.NewComplexValue(sdk.ReservationNetworkClass, entries...))
+	}
+	return sdk.NewMultipleValue(items)
+}
+
+func flattenReservationNetworks(networks *sdk.LiteralValue) []map[string]interface{} {
+	if networks == nil {
+		return []map[string]interface{}{}
+	}
+	flattened := make([]map[string]interface{}, 0, len(networks.Items))
+	for _, network := range networks.Items {
+		flattened = append(flattened, map[string]interface{}{
+			"path_id":            network.Values.Get(sdk.NetworkPathKey).EntityID(),
+			"network_profile_id": network.Values.Get(sdk.NetworkProfileKey).EntityID(),
+		})
+	}
+	return flattened
+}
+
+// expandReservationAlertPolicy returns the alert policy of the alerts block, the alerts are disabled without the block
+func expandReservationAlertPolicy(alerts []interface{}) *sdk.ReservationAlertPolicy {
+	if len(alerts) == 0 || alerts[0] == nil {
+		return &sdk.ReservationAlertPolicy{Enabled: false, Recipients: []string{}, Alerts: []sdk.ReservationAlert{}}
+	}
+	alertsMap := alerts[0].(map[string]interface{})
+	policy := &sdk.ReservationAlertPolicy{
+		Enabled:           true,
+		FrequencyReminder: alertsMap["reminder_frequency_days"].(int),
+		EmailBgMgr:        alertsMap["email_business_group_managers"].(bool),
+		Recipients:        []string{},
+	}
+	for _, recipient := range alertsMap["recipients"].([]interface{}) {
+		policy.Recipients = append(policy.Recipients, recipient.(string))
+	}
+	for _, field := range sortedKeys(alertsMap) {
+		alertID, ok := reservationAlertFields[field]
+		if !ok {
+			continue
+		}
+		policy.Alerts = append(policy.Alerts, sdk.ReservationAlert{
+			ID:                  alertID,
+			ReferenceResourceID: alertID,
+			AlertPercentLevel:   alertsMap[field].(int),
+		})
+	}
+	return policy
+}
+
+func flattenReservationAlertPolicy(policy *sdk.ReservationAlertPolicy) []map[string]interface{} {
+	if policy == nil || !policy.Enabled {
+		return []map[string]interface{}{}
+	}
+	alerts := map[string]interface{}{
+		"recipients":                    policy.Recipients,
+		"email_business_group_managers": policy.EmailBgMgr,
+		"reminder_frequency_days":       policy.FrequencyReminder,
+	}
+	for field, alertID := range reservationAlertFields {
+		for _, alert := range policy.Alerts {
+			if alert.ID == alertID {
+				alerts[field] = alert.AlertPercentLevel
+			}
+		}
+	}
+	return []map[string]interface{}{alerts}
+}
@@ -0,0 +1,163 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+// reservation policy error constants
+const (
+	ReservationPolicyTypeError      = "The reservation policy %s is a %s policy, not a %s policy"
+	ReservationPolicyNotFoundError  = "No %s reservation policy named %s is found"
+	AmbiguousReservationPolicyError = "Several %s reservation policies are named %s"
+)
+
+// reservationPolicyTypeNames are the names of the types of reservation policies in the messages and the logs
+var reservationPolicyTypeNames = map[string]string{
+	sdk.ReservationPolicyTypeCompute: "compute",
+	sdk.ReservationPolicyTypeStorage: "storage",
+}
+
+func resourceVra7ReservationPolicy() *schema.Resource {
+	return reservationPolicyResource(sdk.ReservationPolicyTypeCompute)
+}
+
+func resourceVra7StorageReservationPolicy() *schema.Resource {
+	return reservationPolicyResource(sdk.ReservationPolicyTypeStorage)
+}
+
+// reservationPolicyResource returns the resource of the reservation policies of the type: the reservation
+// policies and the storage reservation policies only differ by their type
+func reservationPolicyResource(policyType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return reservationPolicyCreate(d, meta, policyType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return reservationPolicyRead(d, meta, policyType)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return reservationPolicyUpdate(d, meta, policyType)
+		},
+		Delete: reservationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"configuration_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func reservationPolicyCreate(d *schema.ResourceData, meta interface{}, policyType string) error {
+	log.Info("Creating the %s reservation policy %s", reservationPolicyTypeNames[policyType], d.Get("name").(string))
+	vraClient := meta.(*sdk.APIClient)
+
+	policy := &sdk.ReservationPolicy{ReservationPolicyTypeID: policyType, TenantID: vraClient.Tenant}
+	expandReservationPolicy(d, policy)
+	created, err := vraClient.CreateReservationPolicy(policy)
+	if err != nil {
+		return err
+	}
+	d.SetId(created.ID)
+	log.Info("Finished creating the reservation policy with id %s", d.Id())
+	return reservationPolicyRead(d, meta, policyType)
+}
+
+func reservationPolicyRead(d *schema.ResourceData, meta interface{}, policyType string) error {
+	log.Info("Reading the reservation policy with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	policy, err := vraClient.GetReservationPolicy(d.Id())
+	if sdk.IsNotFoundError(err) {
+		log.Warning("The reservation policy %s is not found, it is removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// a policy of the other type is imported by mistake
+	if policy.ReservationPolicyTypeID != policyType {
+		return fmt.Errorf(ReservationPolicyTypeError, d.Id(),
+			reservationPolicyTypeNames[policy.ReservationPolicyTypeID], reservationPolicyTypeNames[policyType])
+	}
+	return setReservationPolicy(d, policy)
+}
+
+func reservationPolicyUpdate(d *schema.ResourceData, meta interface{}, policyType string) error {
+	log.Info("Updating the reservation policy with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	policy, err := vraClient.GetReservationPolicy(d.Id())
+	if err != nil {
+		return err
+	}
+	expandReservationPolicy(d, policy)
+	if _, err := vraClient.UpdateReservationPolicy(policy); err != nil {
+		return err
+	}
+	log.Info("Finished updating the reservation policy with id %s", d.Id())
+	return reservationPolicyRead(d, meta, policyType)
+}
+
+func reservationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Info("Deleting the reservation policy with id %s", d.Id())
+	vraClient := meta.(*sdk.APIClient)
+
+	err := vraClient.DeleteReservationPolicy(d.Id())
+	if err != nil && !sdk.IsNotFoundError(err) {
+		return err
+	}
+	d.SetId("")
+	log.Info("Finished deleting the reservation policy")
+	return nil
+}
+
+// expandReservationPolicy sets the arguments of the resource in the reservation policy
+func expandReservationPolicy(d *schema.ResourceData, policy *sdk.ReservationPolicy) {
+	policy.Name = d.Get("name").(string)
+	policy.Description = d.Get("description").(string)
+}
+
+// setReservationPolicy sets the reservation policy in the resource or the data source
+func setReservationPolicy(d *schema.ResourceData, policy *sdk.ReservationPolicy) error {
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	value, err := reservationPolicyConfigurationValue(policy)
+	if err != nil {
+		return err
+	}
+	d.Set("configuration_value", value)
+	return nil
+}
+
+// reservationPolicyConfigurationValue returns the JSON reference to the policy that a reservation_policy
+// property of resource_configuration takes
+func reservationPolicyConfigurationValue(policy *sdk.ReservationPolicy) (string, error) {
+	buffer, err := utils.MarshalToJSON(map[string]string{
+		"classId": policy.ReservationPolicyTypeID,
+		"id":      policy.ID,
+		"label":   policy.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buffer.String()), nil
+}
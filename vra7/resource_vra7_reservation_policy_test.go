@@ -0,0 +1,63 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestReservationPolicyRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "e2f3a4b5-0000-4000-8000-000000000004"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.ReservationPolicyAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200,
+		`{"id":"`+id+`","name":"Gold","description":"Gold datastores","reservationPolicyTypeId":"`+sdk.ReservationPolicyTypeStorage+`"}`))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7StorageReservationPolicy().Schema, map[string]interface{}{})
+	d.SetId(id)
+	utils.AssertNilError(t, reservationPolicyRead(d, &client, sdk.ReservationPolicyTypeStorage))
+	utils.AssertEqualsString(t, "Gold datastores", d.Get("description").(string))
+	utils.AssertEqualsString(t, `{"classId":"`+sdk.ReservationPolicyTypeStorage+`","id":"`+id+`","label":"Gold"}`,
+		d.Get("configuration_value").(string))
+
+	// a storage reservation policy is not a reservation policy
+	err := reservationPolicyRead(d, &client, sdk.ReservationPolicyTypeCompute)
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(ReservationPolicyTypeError, id, "storage", "compute"), err.Error())
+}
+
+func TestReservationPolicyDataSourceRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	policiesURL := func(name string) string {
+		return client.BuildEncodedURL(sdk.ReservationPoliciesAPI, map[string]string{
+			"$filter": "name eq '" + name + "'",
+			"page":    "1"})
+	}
+	httpmock.RegisterResponder("GET", policiesURL("Gold"), httpmock.NewStringResponder(200, mockReservationPolicies))
+	httpmock.RegisterResponder("GET", policiesURL("Silver"), httpmock.NewStringResponder(200, `{"content":[],"metadata":{"totalPages":0}}`))
+
+	d := schema.TestResourceDataRaw(t, dataSourceVra7ReservationPolicy().Schema, map[string]interface{}{"name": "Gold"})
+	utils.AssertNilError(t, reservationPolicyDataSourceRead(d, &client, sdk.ReservationPolicyTypeCompute))
+	utils.AssertEqualsString(t, "d1e2f3a4-0000-4000-8000-000000000003", d.Id())
+	utils.AssertEqualsString(t, "Gold clusters", d.Get("description").(string))
+
+	d = schema.TestResourceDataRaw(t, dataSourceVra7StorageReservationPolicy().Schema, map[string]interface{}{"name": "Silver"})
+	err := reservationPolicyDataSourceRead(d, &client, sdk.ReservationPolicyTypeStorage)
+	utils.AssertNotNilError(t, err)
+	utils.AssertEqualsString(t, fmt.Sprintf(ReservationPolicyNotFoundError, "storage", "Silver"), err.Error())
+}
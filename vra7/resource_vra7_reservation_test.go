@@ -0,0 +1,103 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestExpandReservation(t *testing.T) {
+	reservation := &sdk.Reservation{}
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockReservation), reservation))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7Reservation().Schema, map[string]interface{}{
+		"name":                "Development-vSphere",
+		"businessgroup_id":    "6d8a3b6b-4d0a-4f1a-9c16-4e3c1f6c2b2a",
+		"compute_resource_id": "cr-1",
+		"memory_mb":           16384,
+		"storage": []interface{}{
+			map[string]interface{}{"path_id": "ds-2", "size_gb": 200},
+		},
+		"network": []interface{}{
+			map[string]interface{}{"path_id": "net-1"},
+		},
+	})
+	expandReservation(d, "vsphere.local", reservation)
+
+	extensionData := &reservation.ExtensionData
+	utils.AssertEqualsInt(t, 4, reservation.Version)
+	utils.AssertEqualsString(t, "", reservation.ReservationPolicyID)
+	utils.AssertTrue(t, "enabled by default", reservation.Enabled)
+	utils.AssertFalse(t, "alerts disabled without the block", reservation.AlertPolicy.Enabled)
+	utils.AssertEqualsInt(t, 0, extensionData.Get(sdk.MachineQuotaKey).Int())
+	utils.AssertEqualsInt(t, 16384, extensionData.Get(sdk.ReservationMemoryKey).Values.Get(sdk.MemoryReservedSizeKey).Int())
+	storages := extensionData.Get(sdk.ReservationStoragesKey).Items
+	utils.AssertEqualsInt(t, 1, len(storages))
+	utils.AssertEqualsString(t, "ds-2", storages[0].Values.Get(sdk.StoragePathKey).EntityID())
+	utils.AssertTrue(t, "storage enabled by default", storages[0].Values.Get(sdk.StorageEnabledKey).Bool())
+	network := extensionData.Get(sdk.ReservationNetworksKey).Items[0]
+	utils.AssertTrue(t, "no network profile", network.Values.Get(sdk.NetworkProfileKey) == nil)
+	// the extension data that the resource does not manage is kept
+	utils.AssertEqualsInt(t, 6, len(extensionData.Entries))
+}
+
+func TestExpandReservationAlertPolicy(t *testing.T) {
+	policy := expandReservationAlertPolicy([]interface{}{
+		map[string]interface{}{
+			"recipients":                    []interface{}{"ops@example.com"},
+			"email_business_group_managers": true,
+			"reminder_frequency_days":       7,
+			"storage_percent":               90,
+			"memory_percent":                85,
+			"cpu_percent":                   80,
+			"machine_percent":               75,
+		},
+	})
+	utils.AssertTrue(t, "alerts enabled", policy.Enabled)
+	utils.AssertEqualsInt(t, 4, len(policy.Alerts))
+	utils.AssertEqualsString(t, sdk.CPUAlert, policy.Alerts[0].ID)
+	utils.AssertEqualsInt(t, 80, policy.Alerts[0].AlertPercentLevel)
+
+	flattened := flattenReservationAlertPolicy(policy)
+	utils.AssertEqualsInt(t, 90, flattened[0]["storage_percent"].(int))
+	utils.AssertEqualsInt(t, 0, len(flattenReservationAlertPolicy(expandReservationAlertPolicy(nil))))
+}
+
+func TestResourceVra7ReservationRead(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	id := "5e1a6c2f-8d3b-4a7e-9f10-2b3c4d5e6f70"
+	url := client.BuildEncodedURL(fmt.Sprintf(sdk.ReservationAPI, id), nil)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, mockReservation))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7Reservation().Schema, map[string]interface{}{})
+	d.SetId(id)
+	utils.AssertNilError(t, resourceVra7ReservationRead(d, &client))
+	utils.AssertEqualsString(t, "Development-vSphere", d.Get("name").(string))
+	utils.AssertEqualsString(t, "cr-1", d.Get("compute_resource_id").(string))
+	utils.AssertEqualsInt(t, 2, d.Get("priority").(int))
+	utils.AssertEqualsInt(t, 10, d.Get("machine_quota").(int))
+	utils.AssertEqualsInt(t, 8192, d.Get("memory_mb").(int))
+	storage := d.Get("storage").(*schema.Set).List()[0].(map[string]interface{})
+	utils.AssertEqualsString(t, "ds-1", storage["path_id"].(string))
+	utils.AssertEqualsInt(t, 100, storage["size_gb"].(int))
+	utils.AssertFalse(t, "storage disabled", storage["enabled"].(bool))
+	network := d.Get("network").(*schema.Set).List()[0].(map[string]interface{})
+	utils.AssertEqualsString(t, "np-1", network["network_profile_id"].(string))
+	utils.AssertEqualsInt(t, 75, d.Get("alerts.0.machine_percent").(int))
+	utils.AssertEqualsString(t, "ops@example.com", d.Get("alerts.0.recipients.0").(string))
+
+	// a reservation deleted outside terraform is removed from the state
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(404, `{"errors":[]}`))
+	utils.AssertNilError(t, resourceVra7ReservationRead(d, &client))
+	utils.AssertEqualsString(t, "", d.Id())
+}
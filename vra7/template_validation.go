@@ -0,0 +1,179 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// error constants
+const (
+	UnknownComponentError   = "%s: the component %s is not in the catalog item %s"
+	UnknownPropertyError    = "%s: the property %s is not in the request template of the component %s"
+	ValueBelowMinimumError  = "%s: %d is less than the minimum %d allowed by the catalog item"
+	ValueAboveMaximumError  = "%s: %d is greater than the maximum %d allowed by the catalog item"
+	InvalidConfigValueError = "The configuration does not match the catalog item %s:\n%s"
+)
+
+// catalogItemTemplate is the request template of a catalog item and the request schema carrying the
+// constraints of its fields. The schema is nil if it could not be fetched.
+type catalogItemTemplate struct {
+	Template *sdk.CatalogItemRequestTemplate
+	Schema   *sdk.CatalogItemRequestSchema
+}
+
+// catalogItemTemplates caches the request templates by catalog item id, and the catalog item ids by name,
+// so that they are fetched once per run of the provider and not once per planned deployment
+var catalogItemTemplates = struct {
+	sync.Mutex
+	templates map[string]*catalogItemTemplate
+	ids       map[string]string
+}{
+	templates: make(map[string]*catalogItemTemplate),
+	ids:       make(map[string]string),
+}
+
+// getCatalogItemID returns the id of the catalog item with the given name
+func getCatalogItemID(vraClient *sdk.APIClient, catalogItemName string) (string, error) {
+	catalogItemTemplates.Lock()
+	defer catalogItemTemplates.Unlock()
+	if id, ok := catalogItemTemplates.ids[catalogItemName]; ok {
+		return id, nil
+	}
+	id, err := vraClient.ReadCatalogItemByName(catalogItemName)
+	if err != nil {
+		return "", err
+	}
+	catalogItemTemplates.ids[catalogItemName] = id
+	return id, nil
+}
+
+// getCatalogItemTemplate returns the request template and the request schema of the catalog item
+func getCatalogItemTemplate(vraClient *sdk.APIClient, catalogItemID string) (*catalogItemTemplate, error) {
+	catalogItemTemplates.Lock()
+	defer catalogItemTemplates.Unlock()
+	if t, ok := catalogItemTemplates.templates[catalogItemID]; ok {
+		return t, nil
+	}
+	requestTemplate, err := vraClient.GetCatalogItemRequestTemplate(catalogItemID)
+	if err != nil {
+		return nil, err
+	}
+	// without the schema, only the component names and the property keys are validated
+	requestSchema, err := vraClient.GetCatalogItemRequestSchema(catalogItemID)
+	if err != nil {
+		log.Warning("The request schema of the catalog item %s could not be fetched, the cluster and lease bounds are not validated: %v", catalogItemID, err)
+	}
+	t := &catalogItemTemplate{Template: requestTemplate, Schema: requestSchema}
+	catalogItemTemplates.templates[catalogItemID] = t
+	return t, nil
+}
+
+// checkConfigurationAgainstTemplate validates the planned resource_configuration and lease_days, and the
+// deployment_configuration of an XaaS catalog item, against the request template of the catalog item, and the
+// configured property values against their property definitions
+func checkConfigurationAgainstTemplate(d *schema.ResourceDiff, meta interface{}) error {
+	// the catalog item is not known until the resources it depends on are created
+	if !d.NewValueKnown("catalog_item_name") || !d.NewValueKnown("catalog_item_id") || !d.NewValueKnown("resource_configuration") {
+		return nil
+	}
+	vraClient := meta.(*sdk.APIClient)
+	catalogItemID := strings.TrimSpace(d.Get("catalog_item_id").(string))
+	if catalogItemName := strings.TrimSpace(d.Get("catalog_item_name").(string)); catalogItemName != "" {
+		id, err := getCatalogItemID(vraClient, catalogItemName)
+		if err != nil {
+			return err
+		}
+		catalogItemID = id
+	}
+	if catalogItemID == "" {
+		return nil
+	}
+	t, err := getCatalogItemTemplate(vraClient, catalogItemID)
+	if err != nil {
+		return err
+	}
+	resourceConfigurations := expandResourceConfiguration(d.Get("resource_configuration").(*schema.Set).List())
+	errs := t.validate(resourceConfigurations, d.Get("lease_days").(int))
+	unsetProperties := t.unsetProperties(resourceConfigurations)
+	definitions := getPropertyDefinitions(vraClient, configurationPropertyNames(resourceConfigurations, unsetProperties))
+	errs = append(errs, validatePropertyValues(resourceConfigurations, unsetProperties, definitions)...)
+	if d.NewValueKnown("deployment_configuration") {
+		errs = append(errs, t.validateXaaSForm(d.Get("deployment_configuration").(map[string]interface{}))...)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf(InvalidConfigValueError, catalogItemID, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// validate returns an error message for each component name, property key, cluster size and lease
+// of the configuration that the catalog item does not allow
+func (t *catalogItemTemplate) validate(resourceConfigurations []sdk.ResourceConfigurationStruct, leaseDays int) []string {
+	var errs []string
+	if leaseDays != 0 {
+		errs = append(errs, checkBounds("lease_days", leaseDays, t.Schema.GetField(sdk.LeaseDays))...)
+	}
+	for _, rc := range resourceConfigurations {
+		path := fmt.Sprintf("resource_configuration[%s]", rc.ComponentName)
+		componentPath := lookupComponentPath(t.Template.Data, rc.ComponentName)
+		if componentPath == nil {
+			errs = append(errs, fmt.Sprintf(UnknownComponentError, path, rc.ComponentName, t.Template.CatalogItemID))
+			continue
+		}
+		data, _ := sdk.TemplateData(t.Template.Data).Get(componentPath.Append("data"))
+		componentData, _ := data.(map[string]interface{})
+		for _, propertyName := range sortedKeys(rc.Configuration) {
+			// namespaced custom properties, for e.g., VirtualMachine.Network0.Name, are not part of the template
+			if _, ok := componentData[propertyName]; !ok && !strings.Contains(propertyName, ".") {
+				errs = append(errs, fmt.Sprintf(UnknownPropertyError, path+".configuration."+propertyName, propertyName, rc.ComponentName))
+			}
+		}
+		if rc.Cluster != 0 {
+			var clusterField *sdk.RequestSchemaField
+			if componentField := getComponentSchemaField(t.Schema, componentPath); componentField != nil {
+				clusterField = componentField.DataType.Schema.GetField(sdk.Cluster)
+			}
+			errs = append(errs, checkBounds(path+".cluster", rc.Cluster, clusterField)...)
+		}
+	}
+	return errs
+}
+
+// unsetProperties returns, by component name, the sorted properties of the request template of each component
+// that have an empty value and are not set by its configuration
+func (t *catalogItemTemplate) unsetProperties(resourceConfigurations []sdk.ResourceConfigurationStruct) map[string][]string {
+	unset := make(map[string][]string)
+	for _, rc := range resourceConfigurations {
+		componentPath := lookupComponentPath(t.Template.Data, rc.ComponentName)
+		if componentPath == nil {
+			continue
+		}
+		data, _ := sdk.TemplateData(t.Template.Data).Get(componentPath.Append("data"))
+		componentData, _ := data.(map[string]interface{})
+		for _, name := range sortedKeys(componentData) {
+			if value, ok := componentData[name].(string); !ok || value != "" || strings.HasPrefix(name, "_") {
+				continue
+			}
+			if _, ok := rc.Configuration[name]; !ok {
+				unset[rc.ComponentName] = append(unset[rc.ComponentName], name)
+			}
+		}
+	}
+	return unset
+}
+
+// checkBounds returns an error message if the value is outside the minValue and maxValue facets of the field
+func checkBounds(path string, value int, field *sdk.RequestSchemaField) []string {
+	var errs []string
+	if min, ok := field.GetFacetValue(sdk.MinValueFacet); ok && value < convToInt(min) {
+		errs = append(errs, fmt.Sprintf(ValueBelowMinimumError, path, value, convToInt(min)))
+	}
+	if max, ok := field.GetFacetValue(sdk.MaxValueFacet); ok && value > convToInt(max) {
+		errs = append(errs, fmt.Sprintf(ValueAboveMaximumError, path, value, convToInt(max)))
+	}
+	return errs
+}
@@ -0,0 +1,50 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestCatalogItemTemplateValidate(t *testing.T) {
+	var requestTemplate sdk.CatalogItemRequestTemplate
+	var requestSchema sdk.CatalogItemRequestSchema
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockRequestTemplate), &requestTemplate))
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockRequestSchema), &requestSchema))
+	template := &catalogItemTemplate{Template: &requestTemplate, Schema: &requestSchema}
+
+	// valid configuration, including a namespaced custom property
+	errs := template.validate([]sdk.ResourceConfigurationStruct{
+		{
+			ComponentName: "mock.test.machine1",
+			Cluster:       2,
+			Configuration: map[string]interface{}{"cpu": 2, "memory": 2048, "VirtualMachine.Network0.Name": "dvPortGroup"},
+		},
+	}, 10)
+	utils.AssertEqualsInt(t, 0, len(errs))
+
+	errs = template.validate([]sdk.ResourceConfigurationStruct{
+		{
+			ComponentName: "mock.test.machine1",
+			Cluster:       4,
+			Configuration: map[string]interface{}{"cpus": 2},
+		},
+		{
+			ComponentName: "mock.test.machine3",
+		},
+	}, 60)
+	utils.AssertEqualsInt(t, 4, len(errs))
+	utils.AssertEqualsString(t, fmt.Sprintf(ValueAboveMaximumError, "lease_days", 60, 30), errs[0])
+	utils.AssertEqualsString(t, fmt.Sprintf(UnknownPropertyError, "resource_configuration[mock.test.machine1].configuration.cpus", "cpus", "mock.test.machine1"), errs[1])
+	utils.AssertEqualsString(t, fmt.Sprintf(ValueAboveMaximumError, "resource_configuration[mock.test.machine1].cluster", 4, 3), errs[2])
+	utils.AssertEqualsString(t, fmt.Sprintf(UnknownComponentError, "resource_configuration[mock.test.machine3]", "mock.test.machine3", "dhbh-jhdv-ghdv-dhvdd"), errs[3])
+
+	// without the schema, the bounds are not validated
+	template.Schema = nil
+	errs = template.validate([]sdk.ResourceConfigurationStruct{
+		{ComponentName: "mock.test.machine1", Cluster: 4},
+	}, 60)
+	utils.AssertEqualsInt(t, 0, len(errs))
+}
@@ -0,0 +1,132 @@
+package vra7
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// The description of each catalog request posted by terraform ends with a marker, for e.g.,
+// "web servers [tf-4b0d2f6c8a1e9d3b7f52]". The marker is derived from the idempotency_key, so that an
+// apply that did not save the state of the deployment it requested finds and adopts it on the next run.
+// Without a key, a random marker is planned and kept in the state, it only tells the deployments
+// created by terraform apart.
+
+// TerraformMarkerPrefix is the prefix of the markers stamped by terraform
+const TerraformMarkerPrefix = "tf-"
+
+// the marker at the end of a description
+var terraformMarkerPattern = regexp.MustCompile(`\s*\[(` + TerraformMarkerPrefix + `[0-9a-f]{20})\]$`)
+
+// newTerraformMarker returns the marker of the idempotency key, or a random marker without a key
+func newTerraformMarker(idempotencyKey string) (string, error) {
+	var id []byte
+	if idempotencyKey != "" {
+		sum := sha256.Sum256([]byte(idempotencyKey))
+		id = sum[:10]
+	} else {
+		id = make([]byte, 10)
+		if _, err := rand.Read(id); err != nil {
+			return "", err
+		}
+	}
+	return TerraformMarkerPrefix + hex.EncodeToString(id), nil
+}
+
+// stampDescription appends the marker to the description
+func stampDescription(description, marker string) string {
+	if marker == "" {
+		return description
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s [%s]", description, marker))
+}
+
+// parseDescription returns the description without the marker, and the marker if the description has one
+func parseDescription(description string) (string, string) {
+	match := terraformMarkerPattern.FindStringSubmatchIndex(description)
+	if match == nil {
+		return description, ""
+	}
+	return description[:match[0]], description[match[2]:match[3]]
+}
+
+// planTerraformMarker sets the marker of a new deployment, so that it is part of the planned request_payload
+func planTerraformMarker(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("idempotency_key") {
+		return d.SetNewComputed("terraform_marker")
+	}
+	key := d.Get("idempotency_key").(string)
+	if key == "" && d.Get("terraform_marker").(string) != "" {
+		// the random marker already planned is kept
+		return nil
+	}
+	marker, err := newTerraformMarker(key)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("terraform_marker", marker)
+}
+
+// readTerraformMarker sets the description of the deployment without its marker, and the marker
+// of a deployment created by terraform
+func readTerraformMarker(d *schema.ResourceData, deploymentDescription string) {
+	description, marker := parseDescription(deploymentDescription)
+	d.Set("description", description)
+	d.Set("terraform_managed", marker != "")
+	if marker != "" {
+		d.Set("terraform_marker", marker)
+	}
+}
+
+// findMarkedRequest returns the catalog request carrying the marker whose deployment is being provisioned
+// or is active, or nil if there is none. The deployments that were destroyed or whose lease is over are not adopted.
+func findMarkedRequest(vraClient *sdk.APIClient, marker string) (*sdk.CatalogRequest, error) {
+	requests, err := vraClient.FindCatalogRequestsByDescription(marker)
+	if err != nil {
+		return nil, err
+	}
+	for i, request := range requests {
+		if _, m := parseDescription(request.Description); m != marker {
+			continue
+		}
+		switch request.Phase {
+		case sdk.Failed, sdk.Rejected:
+			continue
+		case sdk.Successful:
+			if !isRequestDeploymentActive(vraClient, request.ID) {
+				continue
+			}
+		}
+		return &requests[i], nil
+	}
+	return nil, nil
+}
+
+// isRequestDeploymentActive returns true if the deployment, or the XaaS resource, provisioned by the request is active
+func isRequestDeploymentActive(vraClient *sdk.APIClient, requestID string) bool {
+	deploymentID, err := vraClient.GetDeploymentIDFromRequest(requestID)
+	if err != nil {
+		// the resources of the request are gone
+		return false
+	}
+	if deploymentID != "" {
+		resource, err := vraClient.GetResource(deploymentID)
+		return err == nil && strings.EqualFold(resource.Status, sdk.ResourceStatusActive)
+	}
+	requestResources, err := vraClient.GetRequestResources(requestID)
+	if err != nil {
+		return false
+	}
+	for _, resource := range requestResources.Content {
+		if isXaaSResourceType(resource.ResourceTypeRef.ID) && strings.EqualFold(resource.Status, sdk.ResourceStatusActive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestNewTerraformMarker(t *testing.T) {
+	marker, err := newTerraformMarker("web-0")
+	utils.AssertNilError(t, err)
+	utils.AssertPrefixString(t, TerraformMarkerPrefix, marker)
+	utils.AssertEqualsInt(t, len(TerraformMarkerPrefix)+20, len(marker))
+
+	// the marker of a key is the same on every run
+	again, _ := newTerraformMarker("web-0")
+	utils.AssertEqualsString(t, marker, again)
+	other, _ := newTerraformMarker("web-1")
+	utils.AssertTrue(t, "different keys have different markers", marker != other)
+
+	random1, _ := newTerraformMarker("")
+	random2, _ := newTerraformMarker("")
+	utils.AssertTrue(t, "markers without a key are random", random1 != random2)
+}
+
+func TestParseDescription(t *testing.T) {
+	marker, _ := newTerraformMarker("web-0")
+
+	description, m := parseDescription(stampDescription("web servers", marker))
+	utils.AssertEqualsString(t, "web servers", description)
+	utils.AssertEqualsString(t, marker, m)
+
+	description, m = parseDescription(stampDescription("", marker))
+	utils.AssertEqualsString(t, "", description)
+	utils.AssertEqualsString(t, marker, m)
+
+	description, m = parseDescription("created in the vRA portal [tf-not-a-marker]")
+	utils.AssertEqualsString(t, "created in the vRA portal [tf-not-a-marker]", description)
+	utils.AssertEqualsString(t, "", m)
+
+	utils.AssertEqualsString(t, "web servers", stampDescription("web servers", ""))
+}
+
+func TestReadTerraformMarker(t *testing.T) {
+	// a deployment without an idempotency_key is stamped with the random marker of its plan
+	marker, _ := newTerraformMarker("")
+	p := &ProviderSchema{Description: "web servers", TerraformMarker: marker}
+	requestTemplate := &sdk.CatalogItemRequestTemplate{Data: map[string]interface{}{}}
+	utils.AssertNilError(t, p.mergeIntoRequestTemplate(requestTemplate))
+
+	d := schema.TestResourceDataRaw(t, resourceVra7Deployment().Schema, map[string]interface{}{"description": "web servers"})
+	readTerraformMarker(d, requestTemplate.Description)
+	utils.AssertEqualsString(t, "web servers", d.Get("description").(string))
+	utils.AssertEqualsString(t, marker, d.Get("terraform_marker").(string))
+	utils.AssertTrue(t, "terraform managed", d.Get("terraform_managed").(bool))
+
+	d = schema.TestResourceDataRaw(t, resourceVra7Deployment().Schema, map[string]interface{}{})
+	readTerraformMarker(d, "created in the vRA portal")
+	utils.AssertEqualsString(t, "created in the vRA portal", d.Get("description").(string))
+	utils.AssertFalse(t, "not terraform managed", d.Get("terraform_managed").(bool))
+}
+
+func TestFindMarkedRequest(t *testing.T) {
+	httpmock.ActivateNonDefault(client.Client)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf(sdk.AuthenticationIdentityTokenAPI, mockBaseURL),
+		httpmock.NewStringResponder(200, validAuthResponse))
+
+	marker := "tf-4b0d2f6c8a1e9d3b7f52"
+	url := client.BuildEncodedURL(sdk.ConsumerRequests, map[string]string{
+		"$filter":  "substringof('" + marker + "',description)",
+		"$orderby": "dateSubmitted desc"})
+	requests := func(phases ...string) string {
+		content := make([]string, 0, len(phases))
+		for i, phase := range phases {
+			content = append(content, fmt.Sprintf(`{"id": "request-%d", "description": "web servers [%s]", "phase": "%s"}`, i, marker, phase))
+		}
+		return `{"content": [` + strings.Join(content, ",") + `]}`
+	}
+
+	// a failed request is not adopted, a request in progress is
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, requests(sdk.Failed, sdk.InProgress)))
+	request, err := findMarkedRequest(&client, marker)
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "request in progress adopted", request != nil)
+	utils.AssertEqualsString(t, "request-1", request.ID)
+
+	// a successful request whose resources are gone is not adopted
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, requests(sdk.Successful)))
+	httpmock.RegisterResponder("GET", client.BuildEncodedURL(fmt.Sprintf(sdk.GetRequestResourceViewAPI, "request-0"), map[string]string{"page": "1"}),
+		httpmock.NewStringResponder(200, `{"content": [], "metadata": {"number": 1, "totalPages": 1}}`))
+	request, err = findMarkedRequest(&client, marker)
+	utils.AssertNilError(t, err)
+	utils.AssertTrue(t, "destroyed deployment not adopted", request == nil)
+
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(500, `{}`))
+	_, err = findMarkedRequest(&client, marker)
+	utils.AssertNotNilError(t, err)
+}
@@ -1,9 +1,11 @@
 package vra7
 
 import (
-	"reflect"
+	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/vmware/terraform-provider-vra7/sdk"
 	"github.com/vmware/terraform-provider-vra7/utils"
 )
@@ -31,46 +33,34 @@ func UpdateResourceConfigurationMap(
 	return resourceConfiguration, changed
 }
 
-// ReplaceValueInRequestTemplate replaces the value for a given key in a catalog
-// request template.
-func ReplaceValueInRequestTemplate(templateInterface map[string]interface{}, field string, value interface{}) bool {
-	var replaced bool
-	//Iterate over the map to get field provided as an argument
-	for key, val := range templateInterface {
-		//If value type is map then set recursive call which will fiend field in one level down of map interface
-		if reflect.ValueOf(val).Kind() == reflect.Map {
-			replaced = ReplaceValueInRequestTemplate(val.(map[string]interface{}), field, value)
-			if replaced {
-				return true
-			}
-		} else if key == field && val != value {
-			//If value type is not map then compare field name with provided field name
-			//If both matches then update field value with provided value
-			templateInterface[key] = value
-			if reflect.ValueOf(value).Kind() == reflect.String {
-				templateInterface[key] = utils.UnmarshalJSONStringIfNecessary(field, value)
-			}
-			return true
-		}
+// ReplaceValueInRequestTemplate replaces the value of the field of root in a catalog request or action
+// template, at the path resolved by sdk.TemplateData.Resolve. It returns false if root does not have the
+// field, the maps nested in root are only reached by an explicit path.
+func ReplaceValueInRequestTemplate(templateData map[string]interface{}, root sdk.TemplatePath, field string, value interface{}) (bool, error) {
+	data := sdk.TemplateData(templateData)
+	path, err := data.Resolve(root, field)
+	if err != nil || path == nil {
+		return false, err
 	}
-	return replaced
+	current, _ := data.Get(path)
+	return true, data.Set(path, parsePropertyValue(field, value, current))
 }
 
-// AddValueToRequestTemplate modeled after replaceValueInRequestTemplate
-// for values being added to template vs updating existing ones
-func AddValueToRequestTemplate(templateInterface map[string]interface{}, field string, value interface{}) map[string]interface{} {
-	//simplest case is adding a simple value. Leaving as a func in case there's a need to do more complicated additions later
-	//	templateInterface[data]
-	for k, v := range templateInterface {
-		if reflect.ValueOf(v).Kind() == reflect.Map && k == "data" {
-			template, _ := v.(map[string]interface{})
-			_ = AddValueToRequestTemplate(template, field, value)
-		} else { //if i == "data" {
-			templateInterface[field] = utils.UnmarshalJSONStringIfNecessary(field, value)
-		}
+// ContainsKeyInRequestTemplate returns true if ReplaceValueInRequestTemplate can set the value of
+// the field of root in a catalog request or action template.
+func ContainsKeyInRequestTemplate(templateData map[string]interface{}, root sdk.TemplatePath, field string) (bool, error) {
+	path, err := sdk.TemplateData(templateData).Resolve(root, field)
+	return path != nil, err
+}
+
+// AddValueToRequestTemplate replaces the value of the field of root in a catalog request template,
+// or adds the field to root if it is not in the template
+func AddValueToRequestTemplate(templateData map[string]interface{}, root sdk.TemplatePath, field string, value interface{}) error {
+	replaced, err := ReplaceValueInRequestTemplate(templateData, root, field, value)
+	if err != nil || replaced {
+		return err
 	}
-	//Return updated map interface type
-	return templateInterface
+	return sdk.TemplateData(templateData).Set(root.Append(field), parsePropertyValue(field, value, nil))
 }
 
 // ResourceMapper returns the mapping of resource attributes from ResourceView APIs
@@ -97,3 +87,26 @@ func GetConfiguration(componentName string, resourceConfiguration []sdk.Resource
 	}
 	return m
 }
+
+// sortedKeys returns the keys of the map in alphabetical order, to process them in a deterministic order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateStringInSlice returns a schema validation function that accepts only the valid values
+func validateStringInSlice(valid []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) ([]string, []error) {
+		value := v.(string)
+		for _, s := range valid {
+			if value == s {
+				return nil, nil
+			}
+		}
+		return nil, []error{fmt.Errorf("%s must be one of %s, got %s", k, strings.Join(valid, ", "), value)}
+	}
+}
@@ -0,0 +1,185 @@
+package vra7
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/vmware/terraform-provider-vra7/sdk"
+)
+
+// XaaS error constants
+const (
+	UnknownFormFieldError     = "%s: the field %s is not in the request form of the XaaS catalog item %s"
+	XaaSResourceNotFoundError = "The request %s has neither provisioned a deployment nor an XaaS resource"
+)
+
+// the resource types of the IaaS, software and deployment resources. The other resources are custom
+// resources provisioned by XaaS blueprints
+var nonXaaSResourceTypePrefixes = []string{"Infrastructure.", "Software", "composition.resource.type."}
+
+func xaasResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"resource_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"component_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"outputs": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenXaaSResources(resources []sdk.XaaSResource) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		flattened = append(flattened, map[string]interface{}{
+			"resource_id":    resource.ResourceID,
+			"name":           resource.Name,
+			"resource_type":  resource.ResourceType,
+			"component_name": resource.ComponentName,
+			"outputs":        resource.Outputs,
+		})
+	}
+	return flattened
+}
+
+// isXaaSResourceType returns true if the resource type is a custom resource type of XaaS
+func isXaaSResourceType(resourceType string) bool {
+	if resourceType == "" {
+		return false
+	}
+	for _, prefix := range nonXaaSResourceTypePrefixes {
+		if strings.HasPrefix(resourceType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// isXaaSRequestForm returns true if the request template is the form of an XaaS blueprint, a flat data
+// map of fields without any component
+func isXaaSRequestForm(requestTemplate *sdk.CatalogItemRequestTemplate) bool {
+	for _, value := range requestTemplate.Data {
+		if component, ok := value.(map[string]interface{}); ok {
+			if _, ok := component["data"].(map[string]interface{}); ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validateXaaSForm returns an error message for each field of the deployment_configuration that is not in
+// the request form of an XaaS catalog item. The request template of an IaaS blueprint accepts any custom property.
+func (t *catalogItemTemplate) validateXaaSForm(deploymentConfiguration map[string]interface{}) []string {
+	if !isXaaSRequestForm(t.Template) {
+		return nil
+	}
+	var errs []string
+	for _, field := range sortedKeys(deploymentConfiguration) {
+		if _, ok := t.Template.Data[field]; !ok {
+			errs = append(errs, fmt.Sprintf(UnknownFormFieldError, "deployment_configuration."+field, field, t.Template.CatalogItemID))
+		}
+	}
+	return errs
+}
+
+// newXaaSResourceFromComponent builds the view of an XaaS component of a deployment
+func newXaaSResourceFromComponent(component sdk.DeploymentComponents, componentName string) sdk.XaaSResource {
+	outputs := make(map[string]string)
+	for key, value := range component.Data {
+		if value != nil {
+			outputs[key] = convToString(value)
+		}
+	}
+	return sdk.XaaSResource{
+		ResourceID:    component.ID,
+		Name:          component.Name,
+		ResourceType:  component.Type,
+		ComponentName: componentName,
+		Outputs:       outputs,
+	}
+}
+
+// newXaaSResource builds the view of an XaaS resource provisioned without a deployment
+func newXaaSResource(resource sdk.ResourceContent) sdk.XaaSResource {
+	outputs := make(map[string]string)
+	for _, entry := range resource.ResourceData.Entries {
+		// the value of an entry is typed, for e.g., {"type": "string", "value": "dns01"}
+		if value, ok := entry.Value["value"]; ok {
+			if value != nil {
+				outputs[entry.Key] = convToString(value)
+			}
+		} else if len(entry.Value) > 0 {
+			outputs[entry.Key] = convToString(entry.Value)
+		}
+	}
+	return sdk.XaaSResource{
+		ResourceID:   resource.ID,
+		Name:         resource.Name,
+		ResourceType: resource.ResourceTypeRef.ID,
+		Outputs:      outputs,
+	}
+}
+
+// readXaaSResource reads the resource provisioned by a standalone XaaS catalog item, which vRA does not
+// wrap in a deployment. The XaaS resource takes the place of the deployment, its id is the deployment_id
+// and the deployment_destroy_action is one of its resource actions.
+func readXaaSResource(d *schema.ResourceData, vraClient *sdk.APIClient, requestID string) error {
+	requestResources, err := vraClient.GetRequestResources(requestID)
+	if err != nil {
+		return err
+	}
+	var resource *sdk.ResourceContent
+	xaasResources := make([]sdk.XaaSResource, 0)
+	for i, r := range requestResources.Content {
+		if !isXaaSResourceType(r.ResourceTypeRef.ID) {
+			continue
+		}
+		if resource == nil {
+			resource = &requestResources.Content[i]
+		}
+		xaasResources = append(xaasResources, newXaaSResource(r))
+	}
+	if resource == nil {
+		return fmt.Errorf(XaaSResourceNotFoundError, requestID)
+	}
+
+	d.Set("deployment_id", resource.ID)
+	d.Set("name", resource.Name)
+	d.Set("status", resource.Status)
+	d.Set("lease_state", leaseState(resource.Status, "", time.Now()))
+	d.Set("days_until_expiry", NoExpiry)
+	if err := d.Set("xaas_resources", flattenXaaSResources(xaasResources)); err != nil {
+		return fmt.Errorf("error setting xaas resources - error: %v", err)
+	}
+	if err := d.Set("resource_configuration", flattenResourceConfigurations(nil, nil)); err != nil {
+		return fmt.Errorf("error setting resource configuration - error: %v", err)
+	}
+	log.Info("Finished reading the XaaS resource %s of the request %s", resource.ID, requestID)
+	return nil
+}
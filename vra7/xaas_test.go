@@ -0,0 +1,62 @@
+package vra7
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vra7/sdk"
+	"github.com/vmware/terraform-provider-vra7/utils"
+)
+
+func TestIsXaaSResourceType(t *testing.T) {
+	utils.AssertTrue(t, "custom resource", isXaaSResourceType("Custom.DNSRecord"))
+	utils.AssertFalse(t, "machine", isXaaSResourceType(sdk.InfrastructureVirtual))
+	utils.AssertFalse(t, "network", isXaaSResourceType("Infrastructure.Network.Network.Existing"))
+	utils.AssertFalse(t, "deployment", isXaaSResourceType(sdk.DeploymentResourceType))
+	utils.AssertFalse(t, "no type", isXaaSResourceType(""))
+}
+
+func TestValidateXaaSForm(t *testing.T) {
+	var requestTemplate sdk.CatalogItemRequestTemplate
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockXaaSRequestTemplate), &requestTemplate))
+	template := &catalogItemTemplate{Template: &requestTemplate}
+	utils.AssertTrue(t, "XaaS request form", isXaaSRequestForm(&requestTemplate))
+
+	errs := template.validateXaaSForm(map[string]interface{}{"hostname": "web01", "zone": "example.com"})
+	utils.AssertEqualsInt(t, 0, len(errs))
+
+	errs = template.validateXaaSForm(map[string]interface{}{"hostname": "web01", "ttl": "300"})
+	utils.AssertEqualsInt(t, 1, len(errs))
+	utils.AssertEqualsString(t, fmt.Sprintf(UnknownFormFieldError, "deployment_configuration.ttl", "ttl", "xaas-dns-record"), errs[0])
+
+	// the request template of an IaaS blueprint accepts any custom property
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockRequestTemplate), &requestTemplate))
+	utils.AssertFalse(t, "IaaS request template", isXaaSRequestForm(&requestTemplate))
+	errs = template.validateXaaSForm(map[string]interface{}{"custom.property": "value"})
+	utils.AssertEqualsInt(t, 0, len(errs))
+}
+
+func TestNewXaaSResource(t *testing.T) {
+	var resource sdk.ResourceContent
+	utils.AssertNilError(t, utils.UnmarshalJSON([]byte(mockXaaSResource), &resource))
+
+	xaasResource := newXaaSResource(resource)
+	utils.AssertEqualsString(t, "b7d2b7d0-5e5a-4c35-9d6c-37d1a3b1e0b4", xaasResource.ResourceID)
+	utils.AssertEqualsString(t, "web01.example.com", xaasResource.Name)
+	utils.AssertEqualsString(t, "Custom.DNSRecord", xaasResource.ResourceType)
+	utils.AssertEqualsInt(t, 2, len(xaasResource.Outputs))
+	utils.AssertEqualsString(t, "web01.example.com", xaasResource.Outputs["fqdn"])
+	utils.AssertEqualsString(t, "300", xaasResource.Outputs["ttl"])
+
+	component := sdk.DeploymentComponents{
+		ID:   "c1",
+		Name: "DNS-0001",
+		Type: "Custom.DNSRecord",
+		Data: map[string]interface{}{"Component": "DNS", "fqdn": "web01.example.com", "ttl": 300.0, "owner": nil},
+	}
+	xaasResource = newXaaSResourceFromComponent(component, "App.DNS")
+	utils.AssertEqualsString(t, "App.DNS", xaasResource.ComponentName)
+	utils.AssertEqualsString(t, "300", xaasResource.Outputs["ttl"])
+	_, ok := xaasResource.Outputs["owner"]
+	utils.AssertFalse(t, "null outputs are skipped", ok)
+}